@@ -0,0 +1,228 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const scrubbedToken = "<redacted>"
+
+// apiPath matches the path of a Bot API method call and captures the token and the endpoint.
+var apiPath = regexp.MustCompile(`(?:^|/)bot([^/]+)/([A-Za-z]+)$`)
+
+// Cassette is a recorded sequence of Bot API calls.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single Bot API call and the response to it.
+type Interaction struct {
+	Endpoint string            `json:"endpoint"`
+	Params   map[string]string `json:"params,omitempty"`
+	Files    []File            `json:"files,omitempty"`
+	Status   int               `json:"status"`
+	Response json.RawMessage   `json:"response"`
+	Text     bool              `json:"text,omitempty"` // the response body was not JSON and is stored as a string
+}
+
+// File describes an uploaded file, its content is not recorded.
+type File struct {
+	Field string `json:"field"`
+	Name  string `json:"name"`
+	Size  int64  `json:"size"`
+}
+
+// Load reads a cassette from path.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// Save writes the cassette to path.
+func (c *Cassette) Save(path string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(c); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// body returns the recorded response body.
+func (i *Interaction) body() []byte {
+	if !i.Text {
+		return i.Response
+	}
+
+	var text string
+	if err := json.Unmarshal(i.Response, &text); err != nil {
+		return i.Response
+	}
+
+	return []byte(text)
+}
+
+// setBody stores a response body, bodies that are not JSON are kept as a string.
+func (i *Interaction) setBody(body []byte) {
+	if json.Valid(body) {
+		i.Response = body
+		return
+	}
+
+	i.Response, _ = json.Marshal(string(body))
+	i.Text = true
+}
+
+// matches reports whether i records the same call as other.
+func (i *Interaction) matches(other *Interaction) bool {
+	if i.Endpoint != other.Endpoint || len(i.Params) != len(other.Params) || len(i.Files) != len(other.Files) {
+		return false
+	}
+	for key, value := range i.Params {
+		if v, ok := other.Params[key]; !ok || v != value {
+			return false
+		}
+	}
+	for n, file := range i.Files {
+		if file != other.Files[n] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readCall parses a Bot API request into an interaction and restores its body, so it can still be sent.
+// It returns false for requests that are not method calls, such as file downloads.
+func readCall(req *http.Request) (*Interaction, string, bool, error) {
+	m := apiPath.FindStringSubmatch(req.URL.Path)
+	if m == nil || strings.Contains(req.URL.Path, "/file/bot") {
+		return nil, "", false, nil
+	}
+	token, endpoint := m[1], m[2]
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, "", false, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+
+	call := &Interaction{Endpoint: endpoint, Params: map[string]string{}}
+	for key, values := range req.URL.Query() {
+		call.Params[key] = values[0]
+	}
+
+	mediaType, mediaParams, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, "", false, err
+		}
+		for key := range values {
+			call.Params[key] = values.Get(key)
+		}
+	case "application/json":
+		if err := readJSON(call, body); err != nil {
+			return nil, "", false, err
+		}
+	case "multipart/form-data":
+		if err := readMultipart(call, body, mediaParams["boundary"]); err != nil {
+			return nil, "", false, err
+		}
+	}
+
+	if len(call.Params) == 0 {
+		call.Params = nil
+	}
+
+	return call, token, true, nil
+}
+
+// readJSON adds the fields of a JSON body to call, values other than strings are kept in their JSON form.
+func readJSON(call *Interaction, body []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return err
+	}
+
+	for key, raw := range fields {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = string(raw)
+		}
+		call.Params[key] = value
+	}
+
+	return nil
+}
+
+// readMultipart adds the fields of a multipart body to call, files are recorded by name and size only.
+func readMultipart(call *Interaction, body []byte, boundary string) error {
+	r := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if part.FileName() != "" {
+			size, err := io.Copy(io.Discard, part)
+			if err != nil {
+				return err
+			}
+			call.Files = append(call.Files, File{Field: part.FormName(), Name: part.FileName(), Size: size})
+			continue
+		}
+
+		value, err := io.ReadAll(part)
+		if err != nil {
+			return err
+		}
+		call.Params[part.FormName()] = string(value)
+	}
+}
+
+// scrub removes every occurrence of token from the interaction.
+func (i *Interaction) scrub(token string) {
+	if token == "" {
+		return
+	}
+
+	for key, value := range i.Params {
+		i.Params[key] = strings.ReplaceAll(value, token, scrubbedToken)
+	}
+	for n, file := range i.Files {
+		i.Files[n].Name = strings.ReplaceAll(file.Name, token, scrubbedToken)
+	}
+	i.Response = bytes.ReplaceAll(i.Response, []byte(token), []byte(scrubbedToken))
+}
@@ -0,0 +1,218 @@
+package cassette_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/raminsa/telegram-bot-api/cassette"
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+const token = "123456:secret-token"
+
+// fakeTelegram answers a few Bot API methods like Telegram does.
+func fakeTelegram(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch strings.TrimPrefix(r.URL.Path, "/bot"+token+"/") {
+		case "getMe":
+			_, _ = io.WriteString(w, `{"ok":true,"result":{"id":42,"is_bot":true,"first_name":"Test","username":"test_bot"}}`)
+		case "sendMessage":
+			text := r.FormValue("text")
+			if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+				body, _ := io.ReadAll(r.Body)
+				text = string(body)
+			}
+			_, _ = fmt.Fprintf(w, `{"ok":true,"result":{"message_id":7,"date":0,"chat":{"id":1,"type":"private"},"text":%q}}`, text)
+		case "sendDocument":
+			_, _ = io.WriteString(w, `{"ok":true,"result":{"message_id":8,"date":0,"chat":{"id":1,"type":"private"},"document":{"file_id":"doc","file_unique_id":"u"}}}`)
+		case "getChat":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"ok":false,"error_code":404,"description":"Not Found"}`)
+		}
+	}))
+}
+
+func newBot(t *testing.T, baseUrl string, transport http.RoundTripper, jsonRequests bool) *telegram.Api {
+	t.Helper()
+
+	c := telegram.Client()
+	c.BaseUrl = baseUrl
+	c.Transport = transport
+	tg, err := telegram.NewWithCustomClient(token, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tg.Bot.JSONRequests = jsonRequests
+
+	return tg
+}
+
+// calls runs the same calls against a bot and returns what they produced.
+func calls(tg *telegram.Api) []string {
+	var results []string
+
+	me, err := tg.GetMe()
+	results = append(results, fmt.Sprint(me.UserName, err))
+
+	msg := tg.NewSendMessage()
+	msg.ChatID = 1
+	msg.Text = "<b>hi</b> & bye"
+	sent, err := tg.SendMessage(msg)
+	results = append(results, fmt.Sprint(sent.Text, err))
+
+	doc := tg.NewSendDocument()
+	doc.ChatID = 1
+	doc.Document = tg.FileBytes("report.txt", []byte("content"))
+	sent, err = tg.SendDocument(doc)
+	results = append(results, fmt.Sprint(sent.Document.FileID, err))
+
+	_, err = tg.GetChat(&types.GetChat{ChatID: 2})
+	results = append(results, fmt.Sprint(errors.Is(err, types.ErrChatNotFound)))
+
+	return results
+}
+
+func TestRecordReplay(t *testing.T) {
+	tests := []struct {
+		name         string
+		jsonRequests bool
+	}{
+		{"form requests", false},
+		{"json requests", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cassette.json")
+
+			server := fakeTelegram(t)
+			recorder := cassette.NewRecorder(path, nil)
+			recorded := calls(newBot(t, server.URL, recorder, tt.jsonRequests))
+			server.Close()
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(data), "secret-token") {
+				t.Error("cassette contains the bot token")
+			}
+			if n := len(recorder.Cassette().Interactions); n != 4 {
+				t.Fatalf("recorded %d interactions, want 4", n)
+			}
+
+			replayer, err := cassette.NewReplayer(t, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			//the server is closed, every answer has to come from the cassette
+			replayed := calls(newBot(t, server.URL, replayer, tt.jsonRequests))
+
+			if strings.Join(replayed, "|") != strings.Join(recorded, "|") {
+				t.Errorf("replayed %q, recorded %q", replayed, recorded)
+			}
+			if n := replayer.Remaining(); n != 0 {
+				t.Errorf("Remaining() = %d, want 0", n)
+			}
+		})
+	}
+}
+
+// fakeTB records the failures reported by a Replayer.
+type fakeTB struct {
+	errors []string
+}
+
+func (tb *fakeTB) Helper() {}
+
+func (tb *fakeTB) Errorf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func TestReplayerUnexpectedCall(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	c := &cassette.Cassette{Interactions: []cassette.Interaction{
+		{Endpoint: "getMe", Status: 200, Response: []byte(`{"ok":true,"result":{"id":42,"is_bot":true,"first_name":"Test"}}`)},
+	}}
+	if err := c.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		match bool
+		call  func(tg *telegram.Api) error
+		fails bool
+	}{
+		{"recorded call", false, func(tg *telegram.Api) error { _, err := tg.GetMe(); return err }, false},
+		{"other endpoint", false, func(tg *telegram.Api) error { _, err := tg.GetChat(&types.GetChat{ChatID: 1}); return err }, true},
+		{"match mode other endpoint", true, func(tg *telegram.Api) error { _, err := tg.GetChat(&types.GetChat{ChatID: 1}); return err }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := &fakeTB{}
+			replayer, err := cassette.NewReplayer(tb, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			replayer.Match = tt.match
+
+			err = tt.call(newBot(t, "http://127.0.0.1:1", replayer, false))
+			if failed := err != nil && len(tb.errors) > 0; failed != tt.fails {
+				t.Errorf("call error = %v, reported %v, want failure %v", err, tb.errors, tt.fails)
+			}
+		})
+	}
+}
+
+func TestReplayerMatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	server := fakeTelegram(t)
+	tg := newBot(t, server.URL, cassette.NewRecorder(path, nil), false)
+	for _, text := range []string{"first", "second"} {
+		msg := tg.NewSendMessage()
+		msg.ChatID = 1
+		msg.Text = text
+		if _, err := tg.SendMessage(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server.Close()
+
+	replayer, err := cassette.NewReplayer(t, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayer.Match = true
+	tg = newBot(t, server.URL, replayer, false)
+
+	//calls made in another order are answered by the interaction with the same params
+	for _, text := range []string{"second", "first"} {
+		msg := tg.NewSendMessage()
+		msg.ChatID = 1
+		msg.Text = text
+		sent, err := tg.SendMessage(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sent.Text != text {
+			t.Errorf("replayed text = %q, want %q", sent.Text, text)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package cassette
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Recorder is an http.RoundTripper that forwards Bot API calls and saves every exchange to a cassette file.
+// Set it as client.Config.Transport. Bot tokens are scrubbed from the recorded data, file downloads are not recorded.
+type Recorder struct {
+	path     string
+	next     http.RoundTripper
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a Recorder writing to path, calls are sent through next or http.DefaultTransport if nil.
+func NewRecorder(path string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Recorder{path: path, next: next}
+}
+
+// RoundTrip sends req and records the exchange.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	call, token, ok, err := readCall(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil || !ok {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	call.Status = resp.StatusCode
+	call.setBody(body)
+	call.scrub(token)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, *call)
+	if err = r.cassette.Save(r.path); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := &Cassette{Interactions: make([]Interaction, len(r.cassette.Interactions))}
+	copy(c.Interactions, r.cassette.Interactions)
+
+	return c
+}
@@ -0,0 +1,115 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// TB is the part of testing.TB the replayer reports failures to.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// Replayer is an http.RoundTripper that answers Bot API calls from a cassette without touching the network.
+// Set it as client.Config.Transport. Calls that are not in the cassette fail the test.
+type Replayer struct {
+	Match bool // Optional. Pass True to answer each call with the first unused interaction of the same endpoint, params and files, instead of in recorded order
+
+	tb       TB
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+	next     int
+}
+
+// NewReplayer returns a Replayer serving the cassette stored at path.
+func NewReplayer(tb TB, path string) (*Replayer, error) {
+	c, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Replayer{tb: tb, cassette: c, used: make([]bool, len(c.Interactions))}, nil
+}
+
+// RoundTrip answers req with a recorded response.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.tb.Helper()
+
+	call, token, ok, err := readCall(req)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		r.tb.Errorf("cassette: unexpected request %s %s", req.Method, req.URL.Path)
+		return nil, fmt.Errorf("cassette: unexpected request %s", req.Method)
+	}
+	call.scrub(token)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i, ok := r.find(call)
+	if !ok {
+		r.tb.Errorf("cassette: unexpected call to %s with params %v", call.Endpoint, call.Params)
+		return nil, fmt.Errorf("cassette: unexpected call to %s", call.Endpoint)
+	}
+	r.used[i] = true
+
+	recorded := r.cassette.Interactions[i]
+	body := recorded.body()
+	header := http.Header{}
+	if !recorded.Text {
+		header.Set("Content-Type", "application/json")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// find returns the interaction answering call.
+func (r *Replayer) find(call *Interaction) (int, bool) {
+	if r.Match {
+		for i := range r.cassette.Interactions {
+			if !r.used[i] && r.cassette.Interactions[i].matches(call) {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+
+	if r.next >= len(r.cassette.Interactions) || r.cassette.Interactions[r.next].Endpoint != call.Endpoint {
+		return 0, false
+	}
+	r.next++
+
+	return r.next - 1, true
+}
+
+// Remaining returns the number of recorded interactions that were not replayed yet.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, used := range r.used {
+		if !used {
+			n++
+		}
+	}
+
+	return n
+}
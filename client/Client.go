@@ -3,25 +3,56 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"time"
 )
 
 type Config struct {
-	HttpC             *http.Client
+	HttpC             *http.Client      // Optional. A pre-set client is kept as is and the options below are ignored
+	Transport         http.RoundTripper // Optional. Custom transport, the transport options below are ignored
 	Proxy             string
 	ForceV4           bool
 	DisableSSLVerify  bool
 	ForceAttemptHTTP2 bool
 	BaseUrl           string
+
+	// Transport tuning, zero values keep the net/http defaults.
+	DialTimeout           time.Duration // Optional. Maximum time to establish a connection
+	KeepAlive             time.Duration // Optional. Interval between keep-alive probes of active connections
+	TLSHandshakeTimeout   time.Duration // Optional. Maximum time to wait for a TLS handshake
+	IdleConnTimeout       time.Duration // Optional. Maximum time an idle connection stays open
+	ResponseHeaderTimeout time.Duration // Optional. Maximum time to wait for the response headers after the request was written
+	MaxIdleConns          int           // Optional. Maximum number of idle connections across all hosts
+	MaxIdleConnsPerHost   int           // Optional. Maximum number of idle connections per host
+	MaxConnsPerHost       int           // Optional. Maximum number of connections per host
+	DisableKeepAlives     bool          // Optional. Pass True to use a connection for a single request only
+
+	// TLS options for self-hosted Bot API servers.
+	RootCAs      *x509.CertPool    // Optional. Certificate authorities used to verify the server
+	CAFile       string            // Optional. PEM file with certificate authorities added to the system pool
+	Certificates []tls.Certificate // Optional. Client certificates for mutual TLS
+	CertFile     string            // Optional. PEM client certificate file for mutual TLS, requires KeyFile
+	KeyFile      string            // Optional. PEM client key file for mutual TLS, requires CertFile
 }
 
 // Setup setup a new client to use telegram api
 func (c *Config) Setup() error {
+	if c.HttpC != nil {
+		return nil
+	}
+
+	if c.Transport != nil {
+		c.HttpC = &http.Client{Transport: c.Transport}
+		return nil
+	}
+
 	var proxy func(*http.Request) (*url.URL, error)
 	var myDC func(ctx context.Context, network, addr string) (net.Conn, error)
-	var TLSClientConfig *tls.Config
 	var forceAttemptHTTP2 bool
 
 	if c.Proxy != "" {
@@ -38,18 +69,21 @@ func (c *Config) Setup() error {
 		proxy = nil
 	}
 
-	if c.ForceV4 {
+	if c.ForceV4 || c.DialTimeout != 0 || c.KeepAlive != 0 {
+		dialer := &net.Dialer{Timeout: c.DialTimeout, KeepAlive: c.KeepAlive}
 		myDC = func(ctx context.Context, network string, addr string) (net.Conn, error) {
-			return (&net.Dialer{}).DialContext(ctx, "tcp4", addr)
+			if c.ForceV4 {
+				network = "tcp4"
+			}
+			return dialer.DialContext(ctx, network, addr)
 		}
 	} else {
 		myDC = nil
 	}
 
-	if c.DisableSSLVerify {
-		TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	} else {
-		TLSClientConfig = &tls.Config{InsecureSkipVerify: false}
+	TLSClientConfig, err := c.tlsConfig()
+	if err != nil {
+		return err
 	}
 
 	if c.ForceAttemptHTTP2 {
@@ -58,12 +92,58 @@ func (c *Config) Setup() error {
 
 	c.HttpC = &http.Client{
 		Transport: &http.Transport{
-			Proxy:             proxy,
-			ForceAttemptHTTP2: forceAttemptHTTP2,
-			DialContext:       myDC,
-			TLSClientConfig:   TLSClientConfig,
+			Proxy:                 proxy,
+			ForceAttemptHTTP2:     forceAttemptHTTP2,
+			DialContext:           myDC,
+			TLSClientConfig:       TLSClientConfig,
+			TLSHandshakeTimeout:   c.TLSHandshakeTimeout,
+			IdleConnTimeout:       c.IdleConnTimeout,
+			ResponseHeaderTimeout: c.ResponseHeaderTimeout,
+			MaxIdleConns:          c.MaxIdleConns,
+			MaxIdleConnsPerHost:   c.MaxIdleConnsPerHost,
+			MaxConnsPerHost:       c.MaxConnsPerHost,
+			DisableKeepAlives:     c.DisableKeepAlives,
 		},
 	}
 
 	return nil
 }
+
+// tlsConfig builds the TLS configuration from the certificate options.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: c.DisableSSLVerify,
+		RootCAs:            c.RootCAs,
+		Certificates:       c.Certificates,
+	}
+
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		// Append to a copy, the pool passed in RootCAs belongs to the caller.
+		if config.RootCAs != nil {
+			config.RootCAs = config.RootCAs.Clone()
+		} else {
+			config.RootCAs, err = x509.SystemCertPool()
+			if err != nil {
+				config.RootCAs = x509.NewCertPool()
+			}
+		}
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in CA file")
+		}
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = append(config.Certificates[:len(config.Certificates):len(config.Certificates)], cert)
+	}
+
+	return config, nil
+}
@@ -4,7 +4,16 @@ package config
 const (
 	DefaultBaseUrl  = "https://api.telegram.org"
 	APIEndpoint     = "/bot%s/%s"
-	APIFileEndpoint = DefaultBaseUrl + "/file/bot%s/%s"
+	APIFilePath     = "/file/bot%s/%s"
+	APIFileEndpoint = DefaultBaseUrl + APIFilePath // Deprecated: ignores custom base urls, use BotApi.BaseUrl + APIFilePath
+)
+
+// File size limits in bytes
+const (
+	MaxUploadSize        = 50 << 20   // files sent by the bot through the cloud Bot API server
+	MaxDownloadSize      = 20 << 20   // files downloaded by the bot through the cloud Bot API server
+	MaxLocalUploadSize   = 2000 << 20 // files sent by the bot through a local Bot API server
+	MaxLocalDownloadSize = 2000 << 20 // files downloaded by the bot through a local Bot API server
 )
 
 // Constant values for Endpoints
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//decode the result of any config type into the given type
+	message := tg.NewSendMessage()
+	message.ChatID = 1234
+	message.Text = "text"
+
+	sent, err := telegram.Call[*types.Message](context.Background(), tg, message)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("messageID:", sent.MessageID)
+
+	//call an endpoint that has no typed method yet
+	ok, err := telegram.Call[bool](context.Background(), tg, types.RawRequest{
+		Method: "setMessageReaction",
+		Args:   types.Params{"chat_id": "1234", "message_id": fmt.Sprint(sent.MessageID)},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("result:", ok)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/cassette"
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	//record every call and its response to a cassette file, the bot token is scrubbed
+	client := telegram.Client()
+	client.Transport = cassette.NewRecorder("testdata/getMe.json", nil)
+
+	tg, err := telegram.NewWithCustomClient("BotToken", client)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	me, err := tg.GetMe()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
+
+	//in tests, answer the same calls from the cassette without touching the network:
+	//replayer, err := cassette.NewReplayer(t, "testdata/getMe.json")
+	//client.Transport = replayer
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//cancel the request when the deadline is reached
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	me, err := tg.GetMeCtx(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
+
+	msg := tg.NewSendMessage()
+	msg.ChatID = 1234
+	msg.Text = "some text"
+
+	_, err = tg.SendMessageCtx(ctx, msg)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
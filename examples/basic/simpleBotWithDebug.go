@@ -3,6 +3,8 @@ package main
 import (
 	"fmt"
 	"log"
+	"log/slog"
+	"os"
 
 	"github.com/raminsa/telegram-bot-api/telegram"
 )
@@ -13,23 +15,22 @@ func main() {
 		log.Fatal(err)
 	}
 
-	//active debug mode
+	//active debug mode, records include request params and raw responses
 	tg.Bot.Debug = true
 
-	me, err := tg.GetMe()
+	//write structured logs to a file
+	file, err := os.Create("fileName")
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer file.Close()
 
-	fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
-
-	//access debug log
-	//method 1: write to console
-	fmt.Println(tg.GetLoggerFile())
+	tg.Bot.Logger = slog.New(slog.NewJSONHandler(file, &slog.HandlerOptions{Level: slog.LevelDebug}))
 
-	//method 2: write to file
-	err = tg.WriteLoggerFile("fileName")
+	me, err := tg.GetMe()
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
 }
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//drop updates delivered twice, the last 10000 update ids are remembered
+	tg.Bot.Dedup = telegram.NewMemoryDedupStore(10000)
+	tg.Bot.OnDuplicate = func(update types.Update) {
+		fmt.Println("dropped duplicate update:", update.UpdateID)
+	}
+
+	getUpdates := tg.NewGetUpdates()
+	getUpdates.Timeout = 60
+
+	updates := tg.GetUpdatesChan(getUpdates)
+	for update := range updates {
+		if update.Message != nil {
+			fmt.Println(update.Message.Text)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//register a handler per update kind, other kinds are dropped
+	dispatcher := tg.NewDispatcher()
+	dispatcher.OnMessage(func(c *telegram.UpdateContext) error {
+		return c.ReplyText("you said: " + c.Update.Message.Text)
+	})
+	dispatcher.OnCallbackQuery(func(c *telegram.UpdateContext) error {
+		fmt.Println("callback from:", c.Sender().ID, c.Update.CallbackQuery.Data)
+		return c.AnswerCallback("done")
+	})
+	dispatcher.OnMessageReaction(func(c *telegram.UpdateContext) error {
+		fmt.Println("reaction in chat:", c.Chat().ID)
+		return nil
+	})
+
+	getUpdates := tg.NewGetUpdates()
+	getUpdates.Timeout = 60
+	//only receive the kinds with a handler
+	getUpdates.AllowedUpdates = dispatcher.AllowedUpdates()
+
+	//dispatcher.Handle can also be passed to tg.NewPoller or tg.NewWebhookHandler
+	dispatcher.Run(context.Background(), tg.GetUpdatesChan(getUpdates))
+}
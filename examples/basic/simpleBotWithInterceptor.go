@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//log every api call with its duration
+	tg.Use(telegram.InterceptorFunc(func(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile, next telegram.Invoker) (*types.APIResponse, error) {
+		start := time.Now()
+		resp, err := next(ctx, endpoint, params, files)
+		fmt.Println("endpoint:", endpoint, "files:", len(files), "duration:", time.Since(start), "error:", err)
+		return resp, err
+	}))
+
+	me, err := tg.GetMe()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
+}
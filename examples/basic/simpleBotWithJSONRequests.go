@@ -0,0 +1,27 @@
+package main
+
+import (
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//send requests without files as application/json bodies, uploads stay multipart
+	tg.Bot.JSONRequests = true
+
+	commands := tg.NewSetMyCommands(
+		tg.NewBotCommand("/start", "start the bot"),
+		tg.NewBotCommand("/help", "show help"),
+	)
+
+	_, err = tg.SetMyCommands(commands)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
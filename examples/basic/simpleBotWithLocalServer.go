@@ -0,0 +1,40 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.NewWithBaseUrl("BotToken", "http://localhost:8081")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//the server was started with telegram-bot-api --local
+	tg.Bot.LocalServer = true
+
+	//local files are passed as file:// URIs instead of being uploaded
+	video := tg.NewSendVideo()
+	video.ChatID = 1234
+	video.Video = tg.FilePath("/path/to/video.mp4")
+
+	_, err = tg.SendVideo(video)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//files are read straight from the server disk
+	file, err := os.Create("fileName")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	_, err = tg.DownloadFile("FileID", file)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dispatcher := tg.NewDispatcher()
+	//wrap every handler, the first middleware is the outermost
+	dispatcher.Use(telegram.Recover, telegram.Logger)
+
+	dispatcher.OnMessage(func(c *telegram.UpdateContext) error {
+		return c.ReplyText("hello")
+	})
+
+	//handlers of a group are wrapped in its middleware as well
+	admin := dispatcher.Group(onlyUser(123456789), telegram.Timeout(10*time.Second))
+	admin.OnCallbackQuery(func(c *telegram.UpdateContext) error {
+		//pass c as context, so the call is cancelled after the timeout
+		_, err := c.Api.GetMeCtx(c)
+		if err != nil {
+			return err
+		}
+		return c.AnswerCallback("done")
+	})
+
+	getUpdates := tg.NewGetUpdates()
+	getUpdates.Timeout = 60
+	getUpdates.AllowedUpdates = dispatcher.AllowedUpdates()
+
+	dispatcher.Run(context.Background(), tg.GetUpdatesChan(getUpdates))
+}
+
+// onlyUser stops updates of every other user.
+func onlyUser(userID int64) telegram.Middleware {
+	return func(next telegram.HandlerFunc) telegram.HandlerFunc {
+		return func(c *telegram.UpdateContext) error {
+			sender := c.Sender()
+			if sender == nil || sender.ID != userID {
+				//drop the update without calling the handler
+				return nil
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//re-send to the new supergroup when a group was upgraded
+	tg.Bot.FollowMigration = true
+	tg.Bot.OnChatMigrated = func(fromChatID, toChatID int64) {
+		fmt.Println("chat migrated from", fromChatID, "to", toChatID)
+	}
+
+	msg := tg.NewSendMessage()
+	msg.ChatID = -1234
+	msg.Text = "some text"
+
+	_, err = tg.SendMessage(msg)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//keep the offset of handled updates across restarts
+	tg.Bot.OffsetStore = telegram.NewFileOffsetStore("offset.txt")
+
+	getUpdates := tg.NewGetUpdates()
+	getUpdates.Timeout = 60
+
+	updates := tg.GetUpdatesChan(getUpdates)
+	for update := range updates {
+		if update.Message != nil {
+			fmt.Println(update.Message.Text)
+		}
+
+		//commit the update, it is delivered again after a restart otherwise
+		tg.AckUpdate(update.UpdateID)
+	}
+}
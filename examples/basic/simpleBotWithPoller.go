@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//stop polling on ctrl+c
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	getUpdates := tg.NewGetUpdates()
+	getUpdates.Timeout = 60
+
+	poller := tg.NewPoller(getUpdates, func(ctx context.Context, update types.Update) error {
+		if update.Message != nil {
+			fmt.Println(update.Message.Text)
+		}
+		//returning an error handles the update again after a backoff, up to poller.MaxAttempts times
+		return nil
+	})
+
+	//called when an update is skipped after its last failed attempt
+	poller.OnFailure = func(update types.Update, err error) {
+		fmt.Println("skipped update:", update.UpdateID, err)
+	}
+	poller.Start(ctx)
+
+	//wait until the last update is handled and its offset is confirmed to telegram
+	poller.Wait()
+}
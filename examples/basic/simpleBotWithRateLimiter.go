@@ -0,0 +1,32 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//pace outgoing messages per chat and globally, zero values use Telegram's limits
+	//only send, forward and copy methods are paced unless RateLimits.Endpoints is set
+	tg.Bot.Limiter = telegram.NewRateLimiter(telegram.RateLimits{
+		Global: telegram.Rate{Limit: 25, Per: time.Second},
+	})
+
+	for _, chatID := range []int64{1234, 5678} {
+		msg := tg.NewSendMessage()
+		msg.ChatID = chatID
+		msg.Text = "some text"
+
+		_, err = tg.SendMessage(msg)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
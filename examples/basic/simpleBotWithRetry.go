@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//retry flood control (429), server errors and network errors
+	tg.Bot.Retry = &types.RetryPolicy{
+		MaxAttempts: 5,
+		MaxWait:     time.Minute,
+		//sendMessage is not idempotent, it must be listed to be retried after server and network errors
+		Endpoints: []string{"getMe", "sendMessage"},
+	}
+
+	me, err := tg.GetMe()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
+}
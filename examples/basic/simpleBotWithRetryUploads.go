@@ -0,0 +1,39 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//retry uploads on flood control (429), server and network errors
+	tg.Bot.Retry = &types.RetryPolicy{
+		Endpoints: []string{"sendDocument"},
+	}
+
+	//readers that can not seek are copied to a temporary file, so a failed upload can be sent again
+	tg.Bot.SpoolUploads = true
+
+	resp, err := http.Get("https://example.com/report.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	document := tg.NewSendDocument()
+	document.ChatID = 1234
+	document.Document = types.FileReader{Name: "report.pdf", Reader: resp.Body}
+
+	_, err = tg.SendDocument(document)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tg.Bot.Timeouts = &types.TimeoutPolicy{
+		Default: 30 * time.Second,
+		//getUpdates waits for its timeout parameter plus this margin
+		PollMargin: 5 * time.Second,
+		//uploads get one minute plus the time to send the files at 100KB/s
+		Upload:     time.Minute,
+		UploadRate: 100 * 1024,
+		//per endpoint overrides, a negative value disables the timeout
+		Endpoints: map[string]time.Duration{
+			"getMe":        5 * time.Second,
+			"sendDocument": -1,
+		},
+	}
+
+	getUpdates := tg.NewGetUpdates()
+	getUpdates.Timeout = 100
+
+	updates, err := tg.GetUpdates(getUpdates)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("updates:", len(updates))
+}
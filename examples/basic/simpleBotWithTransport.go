@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	client := telegram.Client()
+
+	//self-hosted bot api server behind mutual TLS
+	client.BaseUrl = "baseUrl"
+	client.CAFile = "caFile.pem"
+	client.CertFile = "clientCert.pem"
+	client.KeyFile = "clientKey.pem"
+
+	//transport tuning
+	client.DialTimeout = 10 * time.Second
+	client.KeepAlive = 30 * time.Second
+	client.TLSHandshakeTimeout = 10 * time.Second
+	client.IdleConnTimeout = 90 * time.Second
+	client.MaxIdleConnsPerHost = 20
+
+	tg, err := telegram.NewWithCustomClient("BotToken", client)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	me, err := tg.GetMe()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
+}
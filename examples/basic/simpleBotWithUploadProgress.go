@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//report the bytes written for the whole request, total is -1 if a file size is unknown
+	ctx := telegram.WithUploadProgress(context.Background(), func(written, total int64) {
+		fmt.Println("uploaded:", written, "of", total)
+	})
+
+	//keep the "sending video" status visible until the upload is done, empty action picks one from the method
+	ctx = telegram.WithUploadChatAction(ctx, "")
+
+	video := tg.NewSendVideo()
+	video.ChatID = 1234
+	video.Video = tg.FilePath("/path/to/video.mp4")
+
+	_, err = tg.SendVideoCtx(ctx, video)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	msg := tg.NewSendMessage()
+	msg.ChatID = 1234
+	msg.ParseMode = tg.ModeMarkdownV2()
+	msg.Text = "*some text"
+
+	_, err = tg.SendMessage(msg)
+	switch {
+	case err == nil:
+	case errors.Is(err, types.ErrBotBlocked):
+		fmt.Println("user blocked the bot")
+	case errors.Is(err, types.ErrCantParseEntities):
+		if parseErr, ok := types.ParseEntitiesError(err); ok {
+			fmt.Println("invalid entity at offset", parseErr.Offset)
+		}
+	default:
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+)
+
+func main() {
+	//every bot is an independent instance
+	for _, token := range []string{"FirstBotToken", "SecondBotToken"} {
+		tg, err := telegram.New(token)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		me, err := tg.GetMe()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println("botID:", me.ID, "botUsername:", me.UserName)
+	}
+}
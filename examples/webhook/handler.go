@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//requests without this secret token are rejected, pass the same token to setWebhook
+	tg.SetSecretToken("BotSecretToken")
+
+	handler := tg.NewWebhookHandler(func(ctx context.Context, update types.Update) error {
+		if update.Message != nil {
+			fmt.Println(update.Message.Text)
+		}
+		//returning an error answers 500, so telegram sends the update again
+		return nil
+	})
+	handler.TelegramIPsOnly = true
+
+	fmt.Println("start at port:", "BotPortNumber")
+	err = http.ListenAndServeTLS("BotPortNumber", "BotCertFile", "BotKeyFile", handler)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
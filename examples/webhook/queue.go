@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tg.SetSecretToken("BotSecretToken")
+
+	//handle updates with 8 workers, the webhook request is answered as soon as the update is queued
+	queue := tg.NewUpdateQueue(func(ctx context.Context, update types.Update) error {
+		if update.Message != nil {
+			fmt.Println(update.Message.Text)
+		}
+		return nil
+	})
+	queue.Size = 1000
+	queue.Workers = 8
+	//write updates to disk when the queue is full, use telegram.OverflowReject to answer 503 instead
+	queue.Overflow = telegram.OverflowSpill
+	queue.SpillDir = "spill"
+
+	err = queue.Start(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//report the queue depth
+	go func() {
+		for range time.Tick(time.Minute) {
+			stats := queue.Stats()
+			fmt.Println("depth:", stats.Depth, "spilled:", stats.Spilled, "handled:", stats.Handled)
+		}
+	}()
+
+	fmt.Println("start at port:", "BotPortNumber")
+	err = http.ListenAndServeTLS("BotPortNumber", "BotCertFile", "BotKeyFile", tg.NewWebhookHandler(queue.Push))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
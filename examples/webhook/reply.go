@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/raminsa/telegram-bot-api/telegram"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func main() {
+	tg, err := telegram.New("BotToken")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	handler := tg.NewWebhookHandler(func(ctx context.Context, update types.Update) error {
+		if update.Message == nil {
+			return nil
+		}
+
+		message := tg.NewSendMessage()
+		message.ChatID = update.Message.Chat.ID
+		message.Text = update.Message.Text
+
+		//a single reply is written to the webhook response, uploads and further replies are sent as requests in order
+		return tg.Reply(ctx, message)
+	})
+
+	fmt.Println("start at port:", "BotPortNumber")
+	err = http.ListenAndServeTLS("BotPortNumber", "BotCertFile", "BotKeyFile", handler)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,23 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// Call sends c to Telegram using ctx and decodes the result into T.
+// Endpoints without a typed method can be called with a types.RawRequest.
+func Call[T any](ctx context.Context, api *Api, c types.Chattable) (T, error) {
+	var result T
+
+	resp, err := api.RequestCtx(ctx, c)
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal(resp.Result, &result)
+
+	return result, err
+}
@@ -0,0 +1,108 @@
+package telegram
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+const defaultDedupWindow = 10000
+
+// MemoryDedupStore is a DedupStore remembering the most recent UpdateIDs in memory.
+type MemoryDedupStore struct {
+	mu     sync.Mutex
+	window []int
+	next   int
+	seen   map[int]int // slot of the window holding each remembered id
+}
+
+// NewMemoryDedupStore returns a DedupStore remembering the last size UpdateIDs, 10000 if size is not positive.
+func NewMemoryDedupStore(size int) *MemoryDedupStore {
+	if size <= 0 {
+		size = defaultDedupWindow
+	}
+
+	return &MemoryDedupStore{
+		window: make([]int, 0, size),
+		seen:   make(map[int]int, size),
+	}
+}
+
+// Seen records updateID and reports whether it is still in the window. The oldest id leaves the window when it is full.
+func (s *MemoryDedupStore) Seen(_ context.Context, updateID int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.seen[updateID]; ok {
+		return true, nil
+	}
+
+	slot := len(s.window)
+	if slot < cap(s.window) {
+		s.window = append(s.window, updateID)
+	} else {
+		slot = s.next
+		// A forgotten id seen again lives in a newer slot, evicting its old slot must keep it.
+		if evicted := s.window[slot]; s.seen[evicted] == slot {
+			delete(s.seen, evicted)
+		}
+		s.window[slot] = updateID
+		s.next = (s.next + 1) % len(s.window)
+	}
+	s.seen[updateID] = slot
+
+	return false, nil
+}
+
+// Forget removes updateID from the window.
+func (s *MemoryDedupStore) Forget(_ context.Context, updateID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.seen, updateID)
+
+	return nil
+}
+
+// duplicate reports whether update was already delivered and must be dropped.
+// Errors of the store are logged and the update is delivered.
+func (t *Api) duplicate(ctx context.Context, update types.Update) bool {
+	if t.Bot.Dedup == nil {
+		return false
+	}
+
+	seen, err := t.Bot.Dedup.Seen(ctx, update.UpdateID)
+	if err != nil {
+		t.logger().Error("failed to check update for duplicates",
+			slog.String("error", err.Error()),
+			slog.Int("update_id", update.UpdateID),
+		)
+		return false
+	}
+	if !seen {
+		return false
+	}
+
+	t.logger().Debug("dropped duplicate update", slog.Int("update_id", update.UpdateID))
+	if t.Bot.OnDuplicate != nil {
+		t.Bot.OnDuplicate(update)
+	}
+
+	return true
+}
+
+// forget removes a delivered update from the dedup store after its handler failed, so a resent copy is handled.
+func (t *Api) forget(ctx context.Context, update types.Update) {
+	if t.Bot.Dedup == nil {
+		return
+	}
+
+	if err := t.Bot.Dedup.Forget(context.WithoutCancel(ctx), update.UpdateID); err != nil {
+		t.logger().Error("failed to forget update",
+			slog.String("error", err.Error()),
+			slog.Int("update_id", update.UpdateID),
+		)
+	}
+}
@@ -0,0 +1,69 @@
+package telegram
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryDedupStore(t *testing.T) {
+	type op struct {
+		forget bool
+		id     int
+		seen   bool
+	}
+
+	tests := []struct {
+		name string
+		size int
+		ops  []op
+	}{
+		{
+			name: "duplicate in window",
+			size: 3,
+			ops:  []op{{id: 1}, {id: 2}, {id: 1, seen: true}, {id: 2, seen: true}},
+		},
+		{
+			name: "oldest leaves window",
+			size: 3,
+			ops:  []op{{id: 1}, {id: 2}, {id: 3}, {id: 4}, {id: 1}, {id: 4, seen: true}},
+		},
+		{
+			name: "forgotten id is delivered again",
+			size: 3,
+			ops:  []op{{id: 1}, {forget: true, id: 1}, {id: 1}, {id: 1, seen: true}},
+		},
+		{
+			name: "forgotten id keeps its new slot",
+			size: 3,
+			ops:  []op{{id: 1}, {forget: true, id: 1}, {id: 1}, {id: 2}, {id: 3}, {id: 1, seen: true}},
+		},
+		{
+			name: "forgotten id leaves window",
+			size: 2,
+			ops:  []op{{id: 1}, {forget: true, id: 1}, {id: 1}, {id: 2}, {id: 3}, {id: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := NewMemoryDedupStore(tt.size)
+			for i, op := range tt.ops {
+				if op.forget {
+					if err := store.Forget(ctx, op.id); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+
+				seen, err := store.Seen(ctx, op.id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if seen != op.seen {
+					t.Errorf("op %d: Seen(%d) = %v, want %v", i, op.id, seen, op.seen)
+				}
+			}
+		})
+	}
+}
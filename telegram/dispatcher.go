@@ -0,0 +1,402 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// HandlerFunc handles one kind of update routed by a Dispatcher.
+type HandlerFunc func(c *UpdateContext) error
+
+// UpdateContext is passed to a HandlerFunc. It is the context of the update, so it can be passed to the context-aware methods.
+type UpdateContext struct {
+	context.Context
+	Update types.Update // The update being handled
+	Api    *Api         // The bot the update was received by
+}
+
+// Message returns the message of a message, edited message, channel post, business message or callback query update, nil otherwise.
+func (c *UpdateContext) Message() *types.Message {
+	u := c.Update
+	switch {
+	case u.Message != nil:
+		return u.Message
+	case u.EditedMessage != nil:
+		return u.EditedMessage
+	case u.ChannelPost != nil:
+		return u.ChannelPost
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost
+	case u.BusinessMessage != nil:
+		return u.BusinessMessage
+	case u.EditedBusinessMessage != nil:
+		return u.EditedBusinessMessage
+	case u.CallbackQuery != nil:
+		return u.CallbackQuery.Message
+	}
+
+	return nil
+}
+
+// Chat returns the chat the update belongs to, nil if it has none.
+func (c *UpdateContext) Chat() *types.Chat {
+	if m := c.Message(); m != nil {
+		return &m.Chat
+	}
+
+	u := c.Update
+	switch {
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.Chat
+	case u.ChatMember != nil:
+		return &u.ChatMember.Chat
+	case u.ChatJoinRequest != nil:
+		return &u.ChatJoinRequest.Chat
+	case u.MessageReaction != nil:
+		return &u.MessageReaction.Chat
+	case u.MessageReactionCount != nil:
+		return &u.MessageReactionCount.Chat
+	case u.DeletedBusinessMessages != nil:
+		return &u.DeletedBusinessMessages.Chat
+	case u.ChatBoost != nil:
+		return &u.ChatBoost.Chat
+	case u.RemovedChatBoost != nil:
+		return &u.RemovedChatBoost.Chat
+	}
+
+	return nil
+}
+
+// Sender returns the user who caused the update, nil if it is unknown.
+func (c *UpdateContext) Sender() *types.User {
+	u := c.Update
+	switch {
+	case u.CallbackQuery != nil:
+		return &u.CallbackQuery.From
+	case u.InlineQuery != nil:
+		return &u.InlineQuery.From
+	case u.ChosenInlineResult != nil:
+		return &u.ChosenInlineResult.From
+	case u.ShippingQuery != nil:
+		return &u.ShippingQuery.From
+	case u.PreCheckoutQuery != nil:
+		return &u.PreCheckoutQuery.From
+	case u.PollAnswer != nil:
+		return u.PollAnswer.User
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.From
+	case u.ChatMember != nil:
+		return &u.ChatMember.From
+	case u.ChatJoinRequest != nil:
+		return &u.ChatJoinRequest.From
+	case u.MessageReaction != nil:
+		return u.MessageReaction.User
+	case u.BusinessConnection != nil:
+		return &u.BusinessConnection.User
+	}
+
+	if m := c.Message(); m != nil {
+		return m.From
+	}
+
+	return nil
+}
+
+// Reply sends chattable with Api.Reply, so a webhook update may be answered in the response body.
+func (c *UpdateContext) Reply(chattable types.Chattable) error {
+	return c.Api.Reply(c, chattable)
+}
+
+// ReplyText sends text to the chat of the update, in the same forum topic and business connection.
+func (c *UpdateContext) ReplyText(text string) error {
+	msg := c.Message()
+	if msg == nil {
+		chat := c.Chat()
+		if chat == nil {
+			return errors.New("update has no chat")
+		}
+		msg = &types.Message{Chat: *chat}
+	}
+
+	send := c.Api.NewSendMessage()
+	send.ChatID = msg.Chat.ID
+	send.BusinessConnectionId = msg.BusinessConnectionId
+	if msg.IsTopicMessage {
+		send.MessageThreadID = int64(msg.MessageThreadID)
+	}
+	send.Text = text
+
+	return c.Reply(send)
+}
+
+// AnswerCallback answers the callback query of the update with text, an empty text only stops the loading indicator.
+func (c *UpdateContext) AnswerCallback(text string) error {
+	if c.Update.CallbackQuery == nil {
+		return errors.New("update is not a callback query")
+	}
+
+	answer := c.Api.NewAnswerCallbackQuery()
+	answer.CallbackQueryID = c.Update.CallbackQuery.ID
+	answer.Text = text
+
+	return c.Reply(answer)
+}
+
+// Dispatcher routes updates to the handler registered for their kind, wrapped in the middleware set by Use and Group.
+// Its Handle method is an UpdateHandler, so it can be passed to NewPoller, NewWebhookHandler or NewUpdateQueue,
+// and Run consumes the channel returned by GetUpdatesChan.
+// Handlers are registered before the first update is dispatched, a later registration for the same kind replaces the handler.
+type Dispatcher struct {
+	Router
+
+	api        *Api
+	handlers   map[string]HandlerFunc
+	other      HandlerFunc
+	middleware []Middleware
+}
+
+// Router registers handlers per update kind on a Dispatcher, wrapped in the middleware of its group.
+type Router struct {
+	dispatcher *Dispatcher
+	middleware []Middleware
+}
+
+// NewDispatcher returns a Dispatcher without handlers.
+func (t *Api) NewDispatcher() *Dispatcher {
+	d := &Dispatcher{
+		api:      t,
+		handlers: make(map[string]HandlerFunc),
+	}
+	d.Router = Router{dispatcher: d}
+
+	return d
+}
+
+// handle registers h for kind, wrapped in the middleware of r.
+func (r *Router) handle(kind string, h HandlerFunc) {
+	r.dispatcher.handlers[kind] = r.wrap(h)
+}
+
+// wrap applies the middleware of r to h, the first one being the outermost.
+func (r *Router) wrap(h HandlerFunc) HandlerFunc {
+	return wrap(h, r.middleware)
+}
+
+// OnMessage handles new incoming messages.
+func (r *Router) OnMessage(h HandlerFunc) {
+	r.handle("message", h)
+}
+
+// OnEditedMessage handles edited messages.
+func (r *Router) OnEditedMessage(h HandlerFunc) {
+	r.handle("edited_message", h)
+}
+
+// OnChannelPost handles new channel posts.
+func (r *Router) OnChannelPost(h HandlerFunc) {
+	r.handle("channel_post", h)
+}
+
+// OnEditedChannelPost handles edited channel posts.
+func (r *Router) OnEditedChannelPost(h HandlerFunc) {
+	r.handle("edited_channel_post", h)
+}
+
+// OnBusinessConnection handles business connections made, edited or removed.
+func (r *Router) OnBusinessConnection(h HandlerFunc) {
+	r.handle("business_connection", h)
+}
+
+// OnBusinessMessage handles new messages from connected business accounts.
+func (r *Router) OnBusinessMessage(h HandlerFunc) {
+	r.handle("business_message", h)
+}
+
+// OnEditedBusinessMessage handles edited messages from connected business accounts.
+func (r *Router) OnEditedBusinessMessage(h HandlerFunc) {
+	r.handle("edited_business_message", h)
+}
+
+// OnDeletedBusinessMessages handles messages deleted from connected business accounts.
+func (r *Router) OnDeletedBusinessMessages(h HandlerFunc) {
+	r.handle("deleted_business_messages", h)
+}
+
+// OnMessageReaction handles reactions to a message changed by a user.
+func (r *Router) OnMessageReaction(h HandlerFunc) {
+	r.handle("message_reaction", h)
+}
+
+// OnMessageReactionCount handles changes of anonymous reactions to a message.
+func (r *Router) OnMessageReactionCount(h HandlerFunc) {
+	r.handle("message_reaction_count", h)
+}
+
+// OnInlineQuery handles inline queries.
+func (r *Router) OnInlineQuery(h HandlerFunc) {
+	r.handle("inline_query", h)
+}
+
+// OnChosenInlineResult handles inline results chosen by a user.
+func (r *Router) OnChosenInlineResult(h HandlerFunc) {
+	r.handle("chosen_inline_result", h)
+}
+
+// OnCallbackQuery handles callback queries.
+func (r *Router) OnCallbackQuery(h HandlerFunc) {
+	r.handle("callback_query", h)
+}
+
+// OnShippingQuery handles shipping queries.
+func (r *Router) OnShippingQuery(h HandlerFunc) {
+	r.handle("shipping_query", h)
+}
+
+// OnPreCheckoutQuery handles pre-checkout queries.
+func (r *Router) OnPreCheckoutQuery(h HandlerFunc) {
+	r.handle("pre_checkout_query", h)
+}
+
+// OnPoll handles new poll states.
+func (r *Router) OnPoll(h HandlerFunc) {
+	r.handle("poll", h)
+}
+
+// OnPollAnswer handles answers changed in non-anonymous polls.
+func (r *Router) OnPollAnswer(h HandlerFunc) {
+	r.handle("poll_answer", h)
+}
+
+// OnMyChatMember handles changes of the bot's chat member status.
+func (r *Router) OnMyChatMember(h HandlerFunc) {
+	r.handle("my_chat_member", h)
+}
+
+// OnChatMember handles changes of a chat member's status.
+func (r *Router) OnChatMember(h HandlerFunc) {
+	r.handle("chat_member", h)
+}
+
+// OnChatJoinRequest handles requests to join a chat.
+func (r *Router) OnChatJoinRequest(h HandlerFunc) {
+	r.handle("chat_join_request", h)
+}
+
+// OnChatBoost handles chat boosts added or changed.
+func (r *Router) OnChatBoost(h HandlerFunc) {
+	r.handle("chat_boost", h)
+}
+
+// OnRemovedChatBoost handles chat boosts removed.
+func (r *Router) OnRemovedChatBoost(h HandlerFunc) {
+	r.handle("removed_chat_boost", h)
+}
+
+// OnOther handles updates of a kind without a registered handler. They are dropped by default.
+func (r *Router) OnOther(h HandlerFunc) {
+	r.dispatcher.other = r.wrap(h)
+}
+
+// AllowedUpdates returns the kinds of update with a registered handler, for GetUpdates.AllowedUpdates or SetWebhook.AllowedUpdates.
+func (d *Dispatcher) AllowedUpdates() []string {
+	kinds := make([]string, 0, len(d.handlers))
+	for kind := range d.handlers {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// Handle passes update to the handler registered for its kind and returns the handler's error.
+func (d *Dispatcher) Handle(ctx context.Context, update types.Update) error {
+	h, ok := d.handlers[updateKind(update)]
+	if !ok {
+		h = d.other
+	}
+	if h == nil {
+		return nil
+	}
+	h = wrap(h, d.middleware)
+
+	return h(&UpdateContext{Context: ctx, Update: update, Api: d.api})
+}
+
+// Run handles the updates of a channel returned by GetUpdatesChan until it is closed or ctx is cancelled.
+// Every update is acknowledged with AckUpdate after it was handled, handler errors are logged.
+// Use Handle with a Poller to have failed updates delivered again.
+func (d *Dispatcher) Run(ctx context.Context, updates types.UpdatesChannel) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			if err := d.Handle(ctx, update); err != nil {
+				d.api.logger().Error("failed to handle update",
+					slog.String("error", err.Error()),
+					slog.Int("update_id", update.UpdateID),
+				)
+			}
+			d.api.AckUpdate(update.UpdateID)
+		}
+	}
+}
+
+// updateKind returns the allowed_updates name of the field set in update, empty if it is unknown.
+func updateKind(u types.Update) string {
+	switch {
+	case u.Message != nil:
+		return "message"
+	case u.EditedMessage != nil:
+		return "edited_message"
+	case u.ChannelPost != nil:
+		return "channel_post"
+	case u.EditedChannelPost != nil:
+		return "edited_channel_post"
+	case u.BusinessConnection != nil:
+		return "business_connection"
+	case u.BusinessMessage != nil:
+		return "business_message"
+	case u.EditedBusinessMessage != nil:
+		return "edited_business_message"
+	case u.DeletedBusinessMessages != nil:
+		return "deleted_business_messages"
+	case u.MessageReaction != nil:
+		return "message_reaction"
+	case u.MessageReactionCount != nil:
+		return "message_reaction_count"
+	case u.InlineQuery != nil:
+		return "inline_query"
+	case u.ChosenInlineResult != nil:
+		return "chosen_inline_result"
+	case u.CallbackQuery != nil:
+		return "callback_query"
+	case u.ShippingQuery != nil:
+		return "shipping_query"
+	case u.PreCheckoutQuery != nil:
+		return "pre_checkout_query"
+	case u.Poll != nil:
+		return "poll"
+	case u.PollAnswer != nil:
+		return "poll_answer"
+	case u.MyChatMember != nil:
+		return "my_chat_member"
+	case u.ChatMember != nil:
+		return "chat_member"
+	case u.ChatJoinRequest != nil:
+		return "chat_join_request"
+	case u.ChatBoost != nil:
+		return "chat_boost"
+	case u.RemovedChatBoost != nil:
+		return "removed_chat_boost"
+	}
+
+	return ""
+}
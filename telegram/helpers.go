@@ -1,114 +1,122 @@
 package telegram
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"net/url"
-	"os"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/raminsa/telegram-bot-api/config"
 	"github.com/raminsa/telegram-bot-api/types"
 )
 
-// SetSecretToken parse secret token for very webhook request
+// SetSecretToken sets the secret token Telegram sends with every webhook request.
+// SetWebhook registers it unless another one is given, and WebhookHandler rejects requests without it.
 func (t *Api) SetSecretToken(secretToken string) {
-	Core.Bot.SecretToken = secretToken
+	t.Bot.SecretToken = secretToken
 }
 
-// WriteDebugLog write debug log to bot log interface
-func (t *Api) WriteDebugLog(msg string) {
-	logger := log.New(&t.Bot.Log, "Debug: ", log.LstdFlags|log.Llongfile)
-	Info := func(info string) {
-		_ = logger.Output(2, info)
-	}
-	Info(msg)
-}
-
-// GetLoggerFile get debug log
-func (t *Api) GetLoggerFile() string {
-	return t.Bot.Log.String()
+// MakeRequest makes a request to a specific endpoint with our token.
+func (t *Api) MakeRequest(endpoint string, params types.Params) (*types.APIResponse, error) {
+	return t.MakeRequestCtx(context.Background(), endpoint, params)
 }
 
-// WriteLoggerFile write debug to file
-func (t *Api) WriteLoggerFile(fileName string) error {
-	return os.WriteFile(fileName, t.Bot.Log.Bytes(), 0666)
+// MakeRequestCtx makes a request to a specific endpoint with our token.
+// Cancellation and deadline are taken from ctx, the bot timeout policy is only applied when ctx has no deadline.
+// The call passes through the registered interceptors and is repeated according to the bot retry policy.
+func (t *Api) MakeRequestCtx(ctx context.Context, endpoint string, params types.Params) (*types.APIResponse, error) {
+	return t.invoke(ctx, endpoint, params, nil)
 }
 
-// MakeRequest makes a request to a specific endpoint with our token.
-func (t *Api) MakeRequest(endpoint string, params types.Params) (*types.APIResponse, error) {
-	if t.Bot.Debug {
-		t.WriteDebugLog(fmt.Sprintf("Endpoint: %s, params: %v\n", endpoint, params))
+// makeRequest performs a single form encoded request.
+func (t *Api) makeRequest(ctx context.Context, endpoint string, params types.Params) (*types.APIResponse, error) {
+	if t.Bot.Limiter != nil {
+		if err := t.Bot.Limiter.Wait(ctx, endpoint, params); err != nil {
+			return nil, err
+		}
 	}
 
 	URL := fmt.Sprintf(t.Bot.BaseUrl+config.APIEndpoint, t.Bot.Token, endpoint)
 
-	values := buildParams(params)
-
-	var timeout time.Duration
-	if t.Bot.RequestTimeout == 0 {
-		timeout = 90 * time.Second
-	} else {
-		timeout = t.Bot.RequestTimeout
+	body, contentType, err := t.encodeParams(params)
+	if err != nil {
+		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := withFallbackTimeout(ctx, t.requestTimeout(endpoint, params, nil))
 	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL, strings.NewReader(values.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL, bytes.NewReader(body))
 	if err != nil {
-		return nil, err
+		return nil, t.redact(err)
 	}
 
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	if t.Bot.SecretToken != "" {
-		req.Header.Set("X-Telegram-Bot-Api-Secret-Token", t.Bot.SecretToken)
-	}
+	req.Header.Set("Content-Type", contentType)
 
-	var resp *http.Response
-	resp, err = t.Bot.Client.Do(req)
-	if err != nil {
+	return t.do(req, endpoint, params, 0)
+}
+
+// UploadFiles makes a request to the API with files.
+func (t *Api) UploadFiles(endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+	return t.UploadFilesCtx(context.Background(), endpoint, params, files)
+}
+
+// UploadFilesCtx makes a request to the API with files.
+// Cancellation and deadline are taken from ctx, the bot timeout policy is only applied when ctx has no deadline.
+// The call passes through the registered interceptors. Uploads are retried only if every file can be replayed.
+func (t *Api) UploadFilesCtx(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+	if err := t.checkUploadSize(files); err != nil {
 		return nil, err
 	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
 
-	var apiResp types.APIResponse
-	var bytes []byte
-	bytes, err = t.decodeAPIResponse(resp.Body, &apiResp)
-	if err != nil {
-		return &apiResp, err
+	if action, ok := ctx.Value(chatActionKey{}).(string); ok {
+		stop := t.keepChatAction(ctx, endpoint, params, action)
+		defer stop()
 	}
 
-	if t.Bot.Debug {
-		t.WriteDebugLog(fmt.Sprintf("Endpoint: %s, response: %s\n", endpoint, string(bytes)))
+	return t.invoke(ctx, endpoint, params, files)
+}
+
+// send is the innermost Invoker, it performs the call with files as multipart upload or as a form encoded request.
+func (t *Api) send(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+	if len(files) == 0 {
+		return t.withRetry(ctx, endpoint, true, func(ctx context.Context) (*types.APIResponse, error) {
+			return t.makeRequest(ctx, endpoint, params)
+		})
 	}
 
-	if !apiResp.Ok {
-		var parameters types.ResponseParameters
-		if apiResp.Parameters != nil {
-			parameters = *apiResp.Parameters
-		}
-		return &apiResp, &types.Error{
-			Code:               apiResp.ErrorCode,
-			Message:            apiResp.Description,
-			ResponseParameters: parameters,
-		}
+	replay, err := t.prepareReplay(files)
+	if err != nil {
+		return nil, err
 	}
+	defer replay.close()
 
-	return &apiResp, nil
+	return t.withRetry(ctx, endpoint, replay.replayable, func(ctx context.Context) (*types.APIResponse, error) {
+		files, err := replay.next()
+		if err != nil {
+			return nil, err
+		}
+		return t.uploadFiles(ctx, endpoint, params, files)
+	})
 }
 
-// UploadFiles makes a request to the API with files.
-func (t *Api) UploadFiles(endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+// uploadFiles performs a single multipart request.
+func (t *Api) uploadFiles(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+	if t.Bot.Limiter != nil {
+		if err := t.Bot.Limiter.Wait(ctx, endpoint, params); err != nil {
+			return nil, err
+		}
+	}
+
 	r, w := io.Pipe()
 	m := multipart.NewWriter(w)
+	progress := newUploadProgress(ctx, files)
 
 	go func() {
 		defer func(w *io.PipeWriter) {
@@ -125,7 +133,7 @@ func (t *Api) UploadFiles(endpoint string, params types.Params, files []types.Re
 			}
 		}
 
-		for _, file := range files {
+		for i, file := range files {
 			if file.Data.NeedsUpload() {
 				name, reader, err := file.Data.UploadData()
 				if err != nil {
@@ -142,7 +150,7 @@ func (t *Api) UploadFiles(endpoint string, params types.Params, files []types.Re
 					return
 				}
 
-				if _, err = io.Copy(part, reader); err != nil {
+				if _, err = io.Copy(part, progress.reader(i, reader)); err != nil {
 					_ = w.CloseWithError(err)
 					return
 				}
@@ -164,106 +172,146 @@ func (t *Api) UploadFiles(endpoint string, params types.Params, files []types.Re
 		}
 	}()
 
-	if t.Bot.Debug {
-		t.WriteDebugLog(fmt.Sprintf("Endpoint: %s, params: %v, with %d files\n", endpoint, params, len(files)))
-	}
-
 	URL := fmt.Sprintf(t.Bot.BaseUrl+config.APIEndpoint, t.Bot.Token, endpoint)
 
-	var timeout time.Duration
-	if t.Bot.RequestTimeout == 0 {
-		timeout = 2 * time.Minute
-	} else {
-		timeout = t.Bot.RequestTimeout
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := withFallbackTimeout(ctx, t.requestTimeout(endpoint, params, files))
 	defer cancel()
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL, r)
 	if err != nil {
-		return nil, err
+		return nil, t.redact(err)
 	}
 
 	req.Header.Set("Content-Type", m.FormDataContentType())
-	if t.Bot.SecretToken != "" {
-		req.Header.Set("X-Telegram-Bot-Api-Secret-Token", t.Bot.SecretToken)
-	}
 
-	var resp *http.Response
-	resp, err = t.Bot.Client.Do(req)
+	return t.do(req, endpoint, params, len(files))
+}
+
+// Request sends a Chattable to Telegram, and returns the APIResponse.
+func (t *Api) Request(c types.Chattable) (*types.APIResponse, error) {
+	return t.RequestCtx(context.Background(), c)
+}
+
+// RequestCtx sends a Chattable to Telegram using ctx, and returns the APIResponse.
+// With LocalServer set, local files are passed to the server as file:// URIs instead of being uploaded.
+// If FollowMigration is set, a request failing because its group was migrated is re-issued against the new supergroup.
+// Uploads are re-issued only if every file can be replayed, see types.ReplayableFileData and BotApi.SpoolUploads.
+func (t *Api) RequestCtx(ctx context.Context, c types.Chattable) (*types.APIResponse, error) {
+	params, err := c.Params()
 	if err != nil {
 		return nil, err
 	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
 
-	var apiResp types.APIResponse
-	var bytes []byte
-	bytes, err = t.decodeAPIResponse(resp.Body, &apiResp)
-	if err != nil {
-		return &apiResp, err
-	}
+	var files []types.RequestFile
+	if f, ok := c.(types.Fileable); ok {
+		files = f.Files()
+		if t.Bot.LocalServer {
+			files = localFiles(params, files)
+		}
 
-	if t.Bot.Debug {
-		t.WriteDebugLog(fmt.Sprintf("Endpoint: %s, response: %s\n", endpoint, string(bytes)))
+		// If there are no files to be uploaded, there are likely things
+		// that need to be turned into params instead.
+		if !hasFilesNeedingUpload(files) {
+			for _, file := range files {
+				params[file.Name] = file.Data.SendData()
+			}
+			files = nil
+		}
 	}
 
-	if !apiResp.Ok {
-		var parameters types.ResponseParameters
-		if apiResp.Parameters != nil {
-			parameters = *apiResp.Parameters
-		}
-		return &apiResp, &types.Error{
-			Code:               apiResp.ErrorCode,
-			Message:            apiResp.Description,
-			ResponseParameters: parameters,
+	replay := &uploadReplay{files: files}
+	if t.Bot.FollowMigration && files != nil {
+		if replay, err = t.replayFiles(files); err != nil {
+			return nil, err
 		}
+		defer replay.close()
+	}
+
+	resp, err := t.requestFiles(ctx, c.EndPoint(), params, replay)
+	if toChatID, ok := t.migrationTarget(params, err); ok && t.Bot.FollowMigration && (files == nil || replay.replayable) {
+		params["chat_id"] = strconv.FormatInt(toChatID, 10)
+		return t.requestFiles(ctx, c.EndPoint(), params, replay)
 	}
 
-	return &apiResp, nil
+	return resp, err
 }
 
-// Request sends a Chattable to Telegram, and returns the APIResponse.
-func (t *Api) Request(c types.Chattable) (*types.APIResponse, error) {
-	params, err := c.Params()
+// requestFiles calls request with the files of replay for the next attempt.
+func (t *Api) requestFiles(ctx context.Context, endpoint string, params types.Params, replay *uploadReplay) (*types.APIResponse, error) {
+	files, err := replay.next()
 	if err != nil {
 		return nil, err
 	}
 
-	if f, ok := c.(types.Fileable); ok {
-		files := f.Files()
-
-		// If we have files that need to be uploaded, we should delegate the
-		// request to UploadFile.
-		if hasFilesNeedingUpload(files) {
-			return t.UploadFiles(f.EndPoint(), params, files)
-		}
+	return t.request(ctx, endpoint, params, files)
+}
 
-		// However, if there are no files to be uploaded, there are likely things
-		// that need to be turned into params instead.
-		for _, file := range files {
-			params[file.Name] = file.Data.SendData()
-		}
+// request delegates to UploadFiles if there are files that need to be uploaded, otherwise to MakeRequest.
+func (t *Api) request(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+	if files != nil {
+		return t.UploadFilesCtx(ctx, endpoint, params, files)
 	}
 
-	return t.MakeRequest(c.EndPoint(), params)
+	return t.MakeRequestCtx(ctx, endpoint, params)
 }
 
 // Send will send a Chattable item to Telegram and provides the returned Message.
 func (t *Api) Send(c types.Chattable) (*types.Message, error) {
-	resp, err := t.Request(c)
+	return t.SendCtx(context.Background(), c)
+}
+
+// SendCtx will send a Chattable item to Telegram using ctx and provides the returned Message.
+func (t *Api) SendCtx(ctx context.Context, c types.Chattable) (*types.Message, error) {
+	return Call[*types.Message](ctx, t, c)
+}
+
+// do sends req and decodes the API response, logging the outcome.
+func (t *Api) do(req *http.Request, endpoint string, params types.Params, files int) (*types.APIResponse, error) {
+	start := time.Now()
+	attrs := []slog.Attr{slog.String("endpoint", endpoint)}
+	if chatID := params["chat_id"]; chatID != "" {
+		attrs = append(attrs, slog.String("chat_id", chatID))
+	}
+	if files > 0 {
+		attrs = append(attrs, slog.Int("files", files))
+	}
+	if t.Bot.Debug {
+		attrs = append(attrs, slog.Any("params", params))
+	}
+
+	resp, err := t.Bot.Client.Do(req)
 	if err != nil {
+		err = t.redact(err)
+		t.logRequest(req.Context(), attrs, start, 0, nil, err)
 		return nil, err
 	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
 
-	var message types.Message
-	err = json.Unmarshal(resp.Result, &message)
+	var apiResp types.APIResponse
+	var bytes []byte
+	bytes, err = t.decodeAPIResponse(resp.Body, &apiResp)
 	if err != nil {
-		return nil, err
+		err = statusError(resp, err)
+		t.logRequest(req.Context(), attrs, start, resp.StatusCode, bytes, err)
+		return &apiResp, err
+	}
+
+	if !apiResp.Ok {
+		var parameters types.ResponseParameters
+		if apiResp.Parameters != nil {
+			parameters = *apiResp.Parameters
+		}
+		err = &types.Error{
+			Code:               apiResp.ErrorCode,
+			Message:            apiResp.Description,
+			ResponseParameters: parameters,
+		}
 	}
 
-	return &message, err
+	t.logRequest(req.Context(), attrs, start, resp.StatusCode, bytes, err)
+
+	return &apiResp, err
 }
 
 // decodeAPIResponse decode response and return slice of bytes if debug enabled.
@@ -287,6 +335,37 @@ func (t *Api) decodeAPIResponse(responseBody io.Reader, resp *types.APIResponse)
 	return data, nil
 }
 
+// withFallbackTimeout derives a context bounded by timeout unless ctx already carries a deadline or timeout is zero.
+func withFallbackTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// statusError reports a server failure as an API error when the response body could not be decoded.
+func statusError(resp *http.Response, err error) error {
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &types.Error{
+			Code:    resp.StatusCode,
+			Message: resp.Status,
+		}
+	}
+
+	return err
+}
+
+// encodeParams returns the body of a request without files, JSON encoded if JSONRequests is set and form encoded otherwise.
+func (t *Api) encodeParams(params types.Params) ([]byte, string, error) {
+	if t.Bot.JSONRequests {
+		body, err := jsonBody(params)
+		return body, "application/json", err
+	}
+
+	return []byte(buildParams(params).Encode()), "application/x-www-form-urlencoded", nil
+}
+
 func buildParams(in types.Params) url.Values {
 	if in == nil {
 		return url.Values{}
@@ -0,0 +1,48 @@
+package telegram
+
+import (
+	"context"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// Invoker performs an API call with the given endpoint, params and files.
+type Invoker func(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error)
+
+// Interceptor wraps every call made through MakeRequest and UploadFiles.
+// It may inspect or modify the request before calling next, and the response or error returned by next.
+// An interceptor that does not call next replaces the call entirely, which is useful for test fakes.
+type Interceptor interface {
+	Intercept(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile, next Invoker) (*types.APIResponse, error)
+}
+
+// InterceptorFunc is an adapter to allow the use of ordinary functions as interceptors.
+type InterceptorFunc func(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile, next Invoker) (*types.APIResponse, error)
+
+// Intercept calls f(ctx, endpoint, params, files, next).
+func (f InterceptorFunc) Intercept(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile, next Invoker) (*types.APIResponse, error) {
+	return f(ctx, endpoint, params, files, next)
+}
+
+// Use registers interceptors around every API call.
+// Interceptors run in the order they were registered, the first one being the outermost.
+// Use must be called before the bot starts making requests.
+func (t *Api) Use(interceptors ...Interceptor) {
+	t.interceptors = append(t.interceptors, interceptors...)
+}
+
+// invoke runs the call through the registered interceptors.
+func (t *Api) invoke(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+	next := t.send
+	for i := len(t.interceptors) - 1; i >= 0; i-- {
+		next = chain(t.interceptors[i], next)
+	}
+
+	return next(ctx, endpoint, params, files)
+}
+
+func chain(interceptor Interceptor, next Invoker) Invoker {
+	return func(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile) (*types.APIResponse, error) {
+		return interceptor.Intercept(ctx, endpoint, params, files, next)
+	}
+}
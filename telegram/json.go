@@ -0,0 +1,79 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// jsonNumber matches values sent as JSON numbers, leading zeros and exponents are kept as strings.
+var jsonNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?$`)
+
+// textParams lists parameters holding free text or string identifiers, they are always sent as JSON strings.
+var textParams = map[string]bool{
+	"action": true, "address": true, "business_connection_id": true, "callback_query_id": true, "caption": true,
+	"currency": true, "custom_emoji_id": true, "custom_title": true, "description": true, "emoji": true,
+	"error_message": true, "explanation": true, "explanation_parse_mode": true, "file_id": true, "first_name": true,
+	"format": true, "foursquare_id": true, "foursquare_type": true, "game_short_name": true, "google_place_id": true,
+	"google_place_type": true, "icon_custom_emoji_id": true, "inline_message_id": true, "inline_query_id": true,
+	"invite_link": true, "ip_address": true, "language_code": true, "last_name": true, "message_effect_id": true,
+	"name": true, "next_offset": true, "old_sticker": true, "parse_mode": true, "payload": true, "performer": true,
+	"phone_number": true, "photo_url": true, "pre_checkout_query_id": true, "provider_data": true,
+	"provider_token": true, "question": true, "question_parse_mode": true, "secret_token": true,
+	"shipping_query_id": true, "short_description": true, "start_parameter": true, "sticker": true,
+	"sticker_format": true, "sticker_set_name": true, "sticker_type": true, "switch_pm_parameter": true,
+	"switch_pm_text": true, "telegram_payment_charge_id": true, "text": true, "title": true, "type": true,
+	"url": true, "vcard": true, "web_app_query_id": true,
+}
+
+// jsonBody encodes params as a JSON object. Numbers, booleans and JSON encoded objects keep their type,
+// everything else, including all textParams, is sent as a string. Params holding a JSON null are left out.
+func jsonBody(params types.Params) ([]byte, error) {
+	body := make(map[string]json.RawMessage, len(params))
+	for key, value := range params {
+		if value == "null" && !textParams[key] {
+			continue
+		}
+		raw, err := jsonValue(key, value)
+		if err != nil {
+			return nil, err
+		}
+		body[key] = raw
+	}
+
+	return encodeJSON(body)
+}
+
+// jsonValue returns the JSON representation of a single param.
+func jsonValue(key, value string) (json.RawMessage, error) {
+	if !textParams[key] {
+		switch {
+		case value == "true" || value == "false":
+			return json.RawMessage(value), nil
+		case jsonNumber.MatchString(value):
+			return json.RawMessage(value), nil
+		case (value != "" && (value[0] == '{' || value[0] == '[')) && json.Valid([]byte(value)):
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, []byte(value)); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		}
+	}
+
+	return encodeJSON(value)
+}
+
+// encodeJSON marshals v without escaping HTML characters, so text stays readable in logs and recordings.
+func encodeJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,70 @@
+package telegram
+
+import (
+	"testing"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestJSONValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		value string
+		want  string
+	}{
+		{"integer", "chat_id", "-1001234567890", `-1001234567890`},
+		{"float", "latitude", "51.5", `51.5`},
+		{"zero", "offset", "0", `0`},
+		{"leading zero", "chat_id", "0123", `"0123"`},
+		{"exponent", "chat_id", "1e5", `"1e5"`},
+		{"username", "chat_id", "@channel", `"@channel"`},
+		{"bool", "disable_notification", "true", `true`},
+		{"object", "reply_markup", `{"inline_keyboard": [[{"text": "a"}]]}`, `{"inline_keyboard":[[{"text":"a"}]]}`},
+		{"array", "allowed_updates", `["message", "callback_query"]`, `["message","callback_query"]`},
+		{"invalid object", "reply_markup", `{"a":`, `"{\"a\":"`},
+		{"empty", "parse_mode", "", `""`},
+		{"numeric text", "text", "42", `"42"`},
+		{"bool text", "caption", "true", `"true"`},
+		{"object text", "text", `{"a":1}`, `"{\"a\":1}"`},
+		{"html text", "text", "<b>a & b</b>", `"<b>a & b</b>"`},
+		{"numeric string id", "callback_query_id", "123456789", `"123456789"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonValue(tt.key, tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("jsonValue(%q, %q) = %s, want %s", tt.key, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONBody(t *testing.T) {
+	tests := []struct {
+		name   string
+		params types.Params
+		want   string
+	}{
+		{"empty", types.Params{}, `{}`},
+		{"mixed", types.Params{"chat_id": "1", "text": "hi", "protect_content": "true"}, `{"chat_id":1,"protect_content":true,"text":"hi"}`},
+		{"null dropped", types.Params{"chat_id": "1", "reply_markup": "null"}, `{"chat_id":1}`},
+		{"null text kept", types.Params{"text": "null"}, `{"text":"null"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonBody(tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("jsonBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
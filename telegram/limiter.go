@@ -0,0 +1,200 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/config"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// ErrRateLimited is returned by RateLimiter when a request can not be sent in time.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// Rate is a number of requests allowed per period.
+type Rate struct {
+	Limit int
+	Per   time.Duration
+}
+
+// RateLimits configures a RateLimiter. Zero rates fall back to Telegram's documented limits.
+type RateLimits struct {
+	Global    Rate            // Optional. Limit for all chats together. Defaults to 30 requests per second
+	Private   Rate            // Optional. Limit for a single private chat. Defaults to 1 request per second
+	Group     Rate            // Optional. Limit for a single group, supergroup or channel. Defaults to 20 requests per minute
+	FailFast  bool            // Optional. Pass True to return ErrRateLimited instead of waiting for a free slot
+	Endpoints map[string]bool // Optional. Endpoints that are paced. Defaults to the send, forward and copy methods except sendChatAction
+}
+
+// RateLimiter is a types.Limiter keeping a token bucket per chat plus a global bucket.
+// Only requests to message sending endpoints with a chat_id parameter are paced,
+// reading or managing a chat, for example getChat or deleteMessage, is not limited.
+type RateLimiter struct {
+	limits    RateLimits
+	mu        sync.Mutex
+	global    *bucket
+	chats     map[string]*bucket
+	lastPrune time.Time
+}
+
+// NewRateLimiter make new rate limiter with the given limits.
+func NewRateLimiter(limits RateLimits) *RateLimiter {
+	if limits.Global.Limit <= 0 || limits.Global.Per <= 0 {
+		limits.Global = Rate{Limit: 30, Per: time.Second}
+	}
+	if limits.Private.Limit <= 0 || limits.Private.Per <= 0 {
+		limits.Private = Rate{Limit: 1, Per: time.Second}
+	}
+	if limits.Group.Limit <= 0 || limits.Group.Per <= 0 {
+		limits.Group = Rate{Limit: 20, Per: time.Minute}
+	}
+
+	return &RateLimiter{
+		limits: limits,
+		global: newBucket(limits.Global, time.Now()),
+		chats:  make(map[string]*bucket),
+	}
+}
+
+// Wait blocks until both the global bucket and the bucket of the target chat have a free token.
+// It fails fast when FailFast is set or when ctx expires before a token becomes available.
+func (l *RateLimiter) Wait(ctx context.Context, endpoint string, params types.Params) error {
+	chatID := params["chat_id"]
+	if chatID == "" || !l.paced(endpoint) {
+		return nil
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	chat, ok := l.chats[chatID]
+	if !ok {
+		chat = newBucket(l.chatRate(chatID), now)
+		l.chats[chatID] = chat
+	}
+	delay := chat.reserve(now)
+	if d := l.global.reserve(now); d > delay {
+		delay = d
+	}
+	l.prune(now)
+
+	if delay > 0 {
+		deadline, hasDeadline := ctx.Deadline()
+		if l.limits.FailFast || (hasDeadline && deadline.Before(now.Add(delay))) {
+			chat.cancel()
+			l.global.cancel()
+			l.mu.Unlock()
+			return ErrRateLimited
+		}
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		chat.cancel()
+		l.global.cancel()
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// paced reports whether requests to endpoint count against the limits.
+func (l *RateLimiter) paced(endpoint string) bool {
+	if l.limits.Endpoints != nil {
+		return l.limits.Endpoints[endpoint]
+	}
+	if endpoint == config.EndpointSendChatAction {
+		return false
+	}
+
+	return strings.HasPrefix(endpoint, "send") || strings.HasPrefix(endpoint, "forward") || strings.HasPrefix(endpoint, "copy")
+}
+
+// chatRate returns the limit for a chat: positive ids are private chats, everything else is a group or channel.
+func (l *RateLimiter) chatRate(chatID string) Rate {
+	if !strings.HasPrefix(chatID, "-") && !strings.HasPrefix(chatID, "@") {
+		return l.limits.Private
+	}
+
+	return l.limits.Group
+}
+
+// prune drops buckets of idle chats so the map does not grow without bound.
+func (l *RateLimiter) prune(now time.Time) {
+	if len(l.chats) < 1024 || now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+
+	for id, b := range l.chats {
+		if b.full(now) {
+			delete(l.chats, id)
+		}
+	}
+}
+
+// bucket is a token bucket. Tokens may go negative to hold reservations for waiting callers.
+type bucket struct {
+	capacity float64
+	perToken time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+func newBucket(rate Rate, now time.Time) *bucket {
+	return &bucket{
+		capacity: float64(rate.Limit),
+		perToken: rate.Per / time.Duration(rate.Limit),
+		tokens:   float64(rate.Limit),
+		last:     now,
+	}
+}
+
+// refill adds the tokens earned since the last update.
+func (b *bucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens += float64(now.Sub(b.last)) / float64(b.perToken)
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+		b.last = now
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait for it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens * float64(b.perToken))
+}
+
+// cancel gives back a token taken by reserve.
+func (b *bucket) cancel() {
+	b.tokens++
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+}
+
+// full reports whether the bucket has refilled completely.
+func (b *bucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.capacity
+}
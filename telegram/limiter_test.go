@@ -0,0 +1,111 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestBucketReserve(t *testing.T) {
+	start := time.Unix(0, 0)
+
+	tests := []struct {
+		name  string
+		rate  Rate
+		after []time.Duration // time of each reservation since start
+		want  []time.Duration // delay returned by each reservation
+	}{
+		{
+			name:  "burst up to capacity",
+			rate:  Rate{Limit: 3, Per: 3 * time.Second},
+			after: []time.Duration{0, 0, 0, 0},
+			want:  []time.Duration{0, 0, 0, time.Second},
+		},
+		{
+			name:  "waiting callers queue up",
+			rate:  Rate{Limit: 1, Per: time.Second},
+			after: []time.Duration{0, 0, 0},
+			want:  []time.Duration{0, time.Second, 2 * time.Second},
+		},
+		{
+			name:  "tokens refill over time",
+			rate:  Rate{Limit: 1, Per: time.Second},
+			after: []time.Duration{0, time.Second, 1500 * time.Millisecond},
+			want:  []time.Duration{0, 0, 500 * time.Millisecond},
+		},
+		{
+			name:  "refill is capped",
+			rate:  Rate{Limit: 2, Per: 2 * time.Second},
+			after: []time.Duration{0, time.Hour, time.Hour, time.Hour},
+			want:  []time.Duration{0, 0, 0, time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBucket(tt.rate, start)
+			for i, after := range tt.after {
+				if got := b.reserve(start.Add(after)); got != tt.want[i] {
+					t.Errorf("reserve %d = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBucketCancel(t *testing.T) {
+	start := time.Unix(0, 0)
+	b := newBucket(Rate{Limit: 1, Per: time.Second}, start)
+
+	b.reserve(start)
+	if d := b.reserve(start); d != time.Second {
+		t.Fatalf("reserve = %v, want 1s", d)
+	}
+	b.cancel()
+	if d := b.reserve(start); d != time.Second {
+		t.Errorf("reserve after cancel = %v, want 1s", d)
+	}
+}
+
+func TestRateLimiterEndpoints(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints map[string]bool
+		endpoint  string
+		params    types.Params
+		limited   bool
+	}{
+		{"send message", nil, "sendMessage", types.Params{"chat_id": "1"}, true},
+		{"forward message", nil, "forwardMessage", types.Params{"chat_id": "-100"}, true},
+		{"copy message", nil, "copyMessage", types.Params{"chat_id": "@channel"}, true},
+		{"chat action", nil, "sendChatAction", types.Params{"chat_id": "1"}, false},
+		{"delete message", nil, "deleteMessage", types.Params{"chat_id": "-100"}, false},
+		{"get chat", nil, "getChat", types.Params{"chat_id": "1"}, false},
+		{"no chat", nil, "sendMessage", types.Params{}, false},
+		{"custom endpoints", map[string]bool{"deleteMessage": true}, "deleteMessage", types.Params{"chat_id": "1"}, true},
+		{"custom endpoints skip others", map[string]bool{"deleteMessage": true}, "sendMessage", types.Params{"chat_id": "1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewRateLimiter(RateLimits{
+				Private:   Rate{Limit: 1, Per: time.Hour},
+				Group:     Rate{Limit: 1, Per: time.Hour},
+				FailFast:  true,
+				Endpoints: tt.endpoints,
+			})
+
+			ctx := context.Background()
+			if err := l.Wait(ctx, tt.endpoint, tt.params); err != nil {
+				t.Fatalf("first Wait() = %v", err)
+			}
+			err := l.Wait(ctx, tt.endpoint, tt.params)
+			if limited := errors.Is(err, ErrRateLimited); limited != tt.limited {
+				t.Errorf("second Wait() = %v, want limited %v", err, tt.limited)
+			}
+		})
+	}
+}
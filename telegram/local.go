@@ -0,0 +1,132 @@
+package telegram
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/raminsa/telegram-bot-api/config"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// fileURL returns the download URL of a file on the configured Bot API server.
+// The URL contains the bot token.
+func (t *Api) fileURL(file *types.File) string {
+	return fmt.Sprintf(t.Bot.BaseUrl+config.APIFilePath, t.Bot.Token, file.FilePath)
+}
+
+// maxUploadSize returns the largest file the bot may send.
+func (t *Api) maxUploadSize() int64 {
+	if t.Bot.LocalServer {
+		return config.MaxLocalUploadSize
+	}
+
+	return config.MaxUploadSize
+}
+
+// maxDownloadSize returns the largest file the bot may download.
+func (t *Api) maxDownloadSize() int64 {
+	if t.Bot.LocalServer {
+		return config.MaxLocalDownloadSize
+	}
+
+	return config.MaxDownloadSize
+}
+
+// checkUploadSize fails early if a file of known size is larger than the upload limit.
+func (t *Api) checkUploadSize(files []types.RequestFile) error {
+	for _, file := range files {
+		if !file.Data.NeedsUpload() {
+			continue
+		}
+		if size, ok := fileSize(file.Data); ok && size > t.maxUploadSize() {
+			return fmt.Errorf("file %s size %d exceeds the upload limit of %d bytes", file.Name, size, t.maxUploadSize())
+		}
+	}
+
+	return nil
+}
+
+// fileSize returns the size of the data to upload if it is known in advance.
+func fileSize(data types.RequestFileData) (int64, bool) {
+	switch d := data.(type) {
+	case types.FileBytes:
+		return int64(len(d.Bytes)), true
+	case *types.FileBytes:
+		return int64(len(d.Bytes)), true
+	case types.FilePath:
+		info, err := os.Stat(string(d))
+		if err != nil {
+			return 0, false
+		}
+		return info.Size(), true
+	case types.FileReader:
+		return readerSize(d)
+	case *types.FileReader:
+		return readerSize(*d)
+	}
+
+	return 0, false
+}
+
+// localFiles replaces local file paths by file:// URIs, so a local Bot API server reads them from its disk.
+// Files referenced from other params through attach:// stay in the multipart upload.
+func localFiles(params types.Params, files []types.RequestFile) []types.RequestFile {
+	local := make([]types.RequestFile, len(files))
+	copy(local, files)
+
+	for i, file := range local {
+		path, ok := file.Data.(types.FilePath)
+		if !ok || isAttached(params, file.Name) {
+			continue
+		}
+
+		abs, err := filepath.Abs(string(path))
+		if err != nil {
+			continue
+		}
+
+		uri := url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}
+		local[i].Data = types.FileURL(uri.String())
+	}
+
+	return local
+}
+
+// isAttached reports whether a param refers to the file field name through attach://.
+func isAttached(params types.Params, name string) bool {
+	for _, value := range params {
+		if strings.Contains(value, "attach://"+name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readerSize returns the size of a FileReader from its Size field or from the reader itself.
+func readerSize(fr types.FileReader) (int64, bool) {
+	if fr.Size > 0 {
+		return fr.Size, true
+	}
+
+	switch r := fr.Reader.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case *os.File:
+		info, err := r.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0, false
+		}
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+		return info.Size() - offset, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,51 @@
+package telegram
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// logger returns the bot logger.
+// Without a configured Logger, records go to slog.Default, or to stderr at debug level when Debug is set.
+// The stderr logger is created once per Api.
+func (t *Api) logger() *slog.Logger {
+	if t.Bot.Logger != nil {
+		return t.Bot.Logger
+	}
+	if t.Bot.Debug {
+		t.debugOnce.Do(func() {
+			t.debugLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		})
+		return t.debugLogger
+	}
+
+	return slog.Default()
+}
+
+// logRequest writes a debug record for a finished API call.
+func (t *Api) logRequest(ctx context.Context, attrs []slog.Attr, start time.Time, status int, body []byte, err error) {
+	logger := t.logger()
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+	if status != 0 {
+		attrs = append(attrs, slog.Int("status", status))
+	}
+	if body != nil {
+		attrs = append(attrs, slog.String("response", string(body)))
+	}
+	if err != nil {
+		if apiErr, ok := types.AsError(err); ok {
+			attrs = append(attrs, slog.Int("error_code", apiErr.Code))
+		}
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "telegram request", attrs...)
+}
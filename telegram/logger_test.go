@@ -0,0 +1,34 @@
+package telegram
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestLogger(t *testing.T) {
+	custom := slog.Default().With("bot", "custom")
+	api := &Api{Bot: &types.BotApi{Logger: custom, Debug: true}}
+	if api.logger() != custom {
+		t.Error("logger() did not return the configured Logger")
+	}
+
+	api = &Api{Bot: &types.BotApi{Debug: true}}
+	first := api.logger()
+	if !first.Enabled(context.Background(), slog.LevelDebug) {
+		t.Error("debug logger does not log at debug level")
+	}
+	if api.logger() != first {
+		t.Error("logger() created a new debug logger on the second call")
+	}
+	if other := (&Api{Bot: &types.BotApi{Debug: true}}).logger(); other == first {
+		t.Error("two bots share a debug logger")
+	}
+
+	api = &Api{Bot: &types.BotApi{}}
+	if api.logger() != slog.Default() {
+		t.Error("logger() without Debug is not slog.Default")
+	}
+}
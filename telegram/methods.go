@@ -1,11 +1,10 @@
 package telegram
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"time"
 
 	"github.com/raminsa/telegram-bot-api/config"
 	"github.com/raminsa/telegram-bot-api/types"
@@ -16,62 +15,113 @@ import (
 // 1. This method will not work if an outgoing webhook is set up.
 // 2. In order to avoid getting duplicate updates, recalculate offset after each server response.
 func (t *Api) GetUpdates(c *types.GetUpdates) ([]types.Update, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var updates []types.Update
-	err = json.Unmarshal(resp.Result, &updates)
+	return t.GetUpdatesCtx(context.Background(), c)
+}
 
-	return updates, err
+// GetUpdatesCtx is the context-aware variant of GetUpdates.
+func (t *Api) GetUpdatesCtx(ctx context.Context, c *types.GetUpdates) ([]types.Update, error) {
+	return Call[[]types.Update](ctx, t, c)
 }
 
 // GetUpdatesChan starts and returns a channel for getting updates.
+// Updates are received by a Poller, StopReceivingUpdates stops it and closes the channel.
+// With an OffsetStore the channel is unbuffered and each update must be confirmed with AckUpdate once it was handled,
+// the next update is delivered after that and the offset is committed.
 func (t *Api) GetUpdatesChan(c *types.GetUpdates) types.UpdatesChannel {
-	t.Bot.GetUpdateChannel = make(chan any)
+	stop := make(chan any)
+	t.mu.Lock()
+	t.Bot.GetUpdateChannel = stop
+	t.ackID, t.ackSignal = 0, nil
+	t.mu.Unlock()
+
+	poller := t.NewPoller(c, nil)
+	size := poller.config.Limit
+	if poller.Store != nil {
+		size = 0
+	}
+	ch := make(chan types.Update, size)
+	poller.handler = func(ctx context.Context, update types.Update) error {
+		var acked <-chan struct{}
+		if poller.Store != nil {
+			acked = t.expectAck(update.UpdateID)
+		}
+
+		select {
+		case ch <- update:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 
-	if c.Limit < 1 || c.Limit > 100 {
-		c.Limit = 100
+		if acked == nil {
+			return nil
+		}
+
+		select {
+		case <-acked:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
-	ch := make(chan types.Update, c.Limit)
 
+	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
-		for {
-			select {
-			case <-t.Bot.GetUpdateChannel:
-				close(ch)
-				return
-			default:
-			}
-
-			updates, err := t.GetUpdates(c)
-			if err != nil {
-				if t.Bot.Debug {
-					t.WriteDebugLog(fmt.Sprintf("Failed to get updates, retrying in 3 seconds... Error:%s", err.Error()))
-				}
-				log.Println(err)
-				log.Println("Failed to get updates, retrying in 3 seconds...")
-				time.Sleep(time.Second * 3)
-
-				continue
-			}
-
-			for _, update := range updates {
-				if update.UpdateID >= c.Offset {
-					c.Offset = update.UpdateID + 1
-					ch <- update
-				}
-			}
-		}
+		<-stop
+		cancel()
+	}()
+
+	poller.Start(ctx)
+	go func() {
+		poller.Wait()
+		cancel()
+		close(ch)
 	}()
 
 	return ch
 }
 
-// StopReceivingUpdates stops the go routine which receives updates
+// AckUpdate confirms that an update received from GetUpdatesChan was handled, so its offset can be committed to the OffsetStore.
+// It is only needed when an OffsetStore is set.
+// Only the ack of the update delivered last releases the next one, acks of other updates, for example a repeated ack of an earlier one, are ignored.
+func (t *Api) AckUpdate(updateID int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.ackSignal == nil || updateID != t.ackID {
+		return
+	}
+
+	close(t.ackSignal)
+	t.ackSignal = nil
+}
+
+// expectAck registers updateID as the update waiting for AckUpdate and returns a channel closed by its ack.
+// Update ids are compared for equality only, since Telegram may continue with a lower id after a week without updates.
+func (t *Api) expectAck(updateID int) <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.ackID = updateID
+	t.ackSignal = make(chan struct{})
+
+	return t.ackSignal
+}
+
+// StopReceivingUpdates stops the go routine which receives updates.
+// It is safe to call more than once.
 func (t *Api) StopReceivingUpdates() {
-	close(t.Bot.GetUpdateChannel)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Bot.GetUpdateChannel == nil {
+		return
+	}
+
+	select {
+	case <-t.Bot.GetUpdateChannel:
+	default:
+		close(t.Bot.GetUpdateChannel)
+	}
 }
 
 // SetWebhook Use this method to specify a URL and receive incoming updates via an outgoing webhook.
@@ -83,7 +133,18 @@ func (t *Api) StopReceivingUpdates() {
 // you can specify secret data in the parameter secret_token.
 // If specified, the request will contain a header “X-Telegram-Bot-Api-Secret-Token” with the secret token as content.
 func (t *Api) SetWebhook(c *types.SetWebhook) (*json.RawMessage, error) {
-	resp, err := t.Request(c)
+	return t.SetWebhookCtx(context.Background(), c)
+}
+
+// SetWebhookCtx is the context-aware variant of SetWebhook.
+func (t *Api) SetWebhookCtx(ctx context.Context, c *types.SetWebhook) (*json.RawMessage, error) {
+	if c.SecretToken == "" && t.Bot.SecretToken != "" {
+		hook := *c
+		hook.SecretToken = t.Bot.SecretToken
+		c = &hook
+	}
+
+	resp, err := t.RequestCtx(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +155,12 @@ func (t *Api) SetWebhook(c *types.SetWebhook) (*json.RawMessage, error) {
 // DeleteWebhook Use this method to remove webhook integration if you decide to switch back to getUpdates.
 // Returns True to success.
 func (t *Api) DeleteWebhook(c *types.DeleteWebhook) (*json.RawMessage, error) {
-	resp, err := t.Request(c)
+	return t.DeleteWebhookCtx(context.Background(), c)
+}
+
+// DeleteWebhookCtx is the context-aware variant of DeleteWebhook.
+func (t *Api) DeleteWebhookCtx(ctx context.Context, c *types.DeleteWebhook) (*json.RawMessage, error) {
+	resp, err := t.RequestCtx(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -107,34 +173,31 @@ func (t *Api) DeleteWebhook(c *types.DeleteWebhook) (*json.RawMessage, error) {
 // On success, returns a WebhookInfo object.
 // If the bot is using getUpdates, will return an object with the url field empty.
 func (t *Api) GetWebhook() (*types.WebhookInfo, error) {
-	resp, err := t.MakeRequest(config.EndpointGetWebhook, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var webhookInfo types.WebhookInfo
-	err = json.Unmarshal(resp.Result, &webhookInfo)
+	return t.GetWebhookCtx(context.Background())
+}
 
-	return &webhookInfo, err
+// GetWebhookCtx is the context-aware variant of GetWebhook.
+func (t *Api) GetWebhookCtx(ctx context.Context) (*types.WebhookInfo, error) {
+	return Call[*types.WebhookInfo](ctx, t, types.RawRequest{Method: config.EndpointGetWebhook})
 }
 
 // GetMe A simple method for testing your bot authentication token.
 // Requires no parameters.
 // Returns basic information about the bot in the form of a User object.
 func (t *Api) GetMe() (*types.User, error) {
-	resp, err := t.MakeRequest(config.EndpointGetMe, nil)
+	return t.GetMeCtx(context.Background())
+}
+
+// GetMeCtx is the context-aware variant of GetMe.
+func (t *Api) GetMeCtx(ctx context.Context) (*types.User, error) {
+	user, err := Call[*types.User](ctx, t, types.RawRequest{Method: config.EndpointGetMe})
 	if err != nil {
 		return nil, err
 	}
 
-	var user types.User
-	err = json.Unmarshal(resp.Result, &user)
-
-	if err == nil {
-		user.IDString = fmt.Sprintf("%d", user.ID)
-	}
+	user.IDString = fmt.Sprintf("%d", user.ID)
 
-	return &user, err
+	return user, nil
 }
 
 // LogOut Use this method to log out from the cloud Bot API server before launching the bot locally.
@@ -145,18 +208,12 @@ func (t *Api) GetMe() (*types.User, error) {
 // Returns True to success.
 // Requires no parameters.
 func (t *Api) LogOut() (bool, error) {
-	resp, err := t.MakeRequest(config.EndpointLogOut, nil)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+	return t.LogOutCtx(context.Background())
+}
 
-	return result, err
+// LogOutCtx is the context-aware variant of LogOut.
+func (t *Api) LogOutCtx(ctx context.Context) (bool, error) {
+	return Call[bool](ctx, t, types.RawRequest{Method: config.EndpointLogOut})
 }
 
 // Close Use this method to close the bot instance before moving it from one local server to another.
@@ -168,22 +225,21 @@ func (t *Api) LogOut() (bool, error) {
 // success.
 // Requires no parameters.
 func (t *Api) Close() (bool, error) {
-	resp, err := t.MakeRequest(config.EndpointClose, nil)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+	return t.CloseCtx(context.Background())
+}
 
-	return result, err
+// CloseCtx is the context-aware variant of Close.
+func (t *Api) CloseCtx(ctx context.Context) (bool, error) {
+	return Call[bool](ctx, t, types.RawRequest{Method: config.EndpointClose})
 }
 
 // SendMessage Use this method to send text messages. On success, the sent Message is returned.
 func (t *Api) SendMessage(c *types.SendMessage) (*types.Message, error) {
+	return t.SendMessageCtx(context.Background(), c)
+}
+
+// SendMessageCtx is the context-aware variant of SendMessage.
+func (t *Api) SendMessageCtx(ctx context.Context, c *types.SendMessage) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -191,13 +247,18 @@ func (t *Api) SendMessage(c *types.SendMessage) (*types.Message, error) {
 		return nil, errors.New("text Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // ForwardMessage Use this method to forward messages of any kind.
 // Service messages can't be forwarded.
 // On success, the sent Message is returned.
 func (t *Api) ForwardMessage(c *types.ForwardMessage) (*types.Message, error) {
+	return t.ForwardMessageCtx(context.Background(), c)
+}
+
+// ForwardMessageCtx is the context-aware variant of ForwardMessage.
+func (t *Api) ForwardMessageCtx(ctx context.Context, c *types.ForwardMessage) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -208,7 +269,7 @@ func (t *Api) ForwardMessage(c *types.ForwardMessage) (*types.Message, error) {
 		return nil, errors.New("MessageID Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // ForwardMessages Use this method to forward multiple messages of any kind.
@@ -217,6 +278,11 @@ func (t *Api) ForwardMessage(c *types.ForwardMessage) (*types.Message, error) {
 // Album grouping is kept for forwarded messages.
 // On success, an array of MessageId of the sent messages is returned.
 func (t *Api) ForwardMessages(c *types.ForwardMessages) ([]types.MessageID, error) {
+	return t.ForwardMessagesCtx(context.Background(), c)
+}
+
+// ForwardMessagesCtx is the context-aware variant of ForwardMessages.
+func (t *Api) ForwardMessagesCtx(ctx context.Context, c *types.ForwardMessages) ([]types.MessageID, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -227,15 +293,7 @@ func (t *Api) ForwardMessages(c *types.ForwardMessages) ([]types.MessageID, erro
 		return nil, errors.New("MessageIds Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var messages []types.MessageID
-	err = json.Unmarshal(resp.Result, &messages)
-
-	return messages, err
+	return Call[[]types.MessageID](ctx, t, c)
 }
 
 // CopyMessage Use this method to copy messages of any kind.
@@ -244,6 +302,11 @@ func (t *Api) ForwardMessages(c *types.ForwardMessages) ([]types.MessageID, erro
 // but the copied message doesn't have a link to the original message.
 // Returns the MessageId of the sent message on success.
 func (t *Api) CopyMessage(c *types.CopyMessage) (*types.Message, error) {
+	return t.CopyMessageCtx(context.Background(), c)
+}
+
+// CopyMessageCtx is the context-aware variant of CopyMessage.
+func (t *Api) CopyMessageCtx(ctx context.Context, c *types.CopyMessage) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -254,7 +317,7 @@ func (t *Api) CopyMessage(c *types.CopyMessage) (*types.Message, error) {
 		return nil, errors.New("MessageID Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // CopyMessages Use this method to copy messages of any kind.
@@ -265,6 +328,11 @@ func (t *Api) CopyMessage(c *types.CopyMessage) (*types.Message, error) {
 // Album grouping is kept for copied messages.
 // On success, an array of MessageId of the sent messages is returned.
 func (t *Api) CopyMessages(c *types.CopyMessages) ([]types.MessageID, error) {
+	return t.CopyMessagesCtx(context.Background(), c)
+}
+
+// CopyMessagesCtx is the context-aware variant of CopyMessages.
+func (t *Api) CopyMessagesCtx(ctx context.Context, c *types.CopyMessages) ([]types.MessageID, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -275,19 +343,16 @@ func (t *Api) CopyMessages(c *types.CopyMessages) ([]types.MessageID, error) {
 		return nil, errors.New("MessageIds Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var messages []types.MessageID
-	err = json.Unmarshal(resp.Result, &messages)
-
-	return messages, err
+	return Call[[]types.MessageID](ctx, t, c)
 }
 
 // SendPhoto Use this method to send photos. On success, the sent Message is returned.
 func (t *Api) SendPhoto(c *types.SendPhoto) (*types.Message, error) {
+	return t.SendPhotoCtx(context.Background(), c)
+}
+
+// SendPhotoCtx is the context-aware variant of SendPhoto.
+func (t *Api) SendPhotoCtx(ctx context.Context, c *types.SendPhoto) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -295,7 +360,7 @@ func (t *Api) SendPhoto(c *types.SendPhoto) (*types.Message, error) {
 		return nil, errors.New("photo Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendAudio Use this method to send audio files if you want Telegram clients to display them in the music player.
@@ -304,6 +369,11 @@ func (t *Api) SendPhoto(c *types.SendPhoto) (*types.Message, error) {
 // Bots can currently send audio files of up to 50 MB in size, this limit may be changed in the future.
 // For sending voice messages, use the sendVoice method instead.
 func (t *Api) SendAudio(c *types.SendAudio) (*types.Message, error) {
+	return t.SendAudioCtx(context.Background(), c)
+}
+
+// SendAudioCtx is the context-aware variant of SendAudio.
+func (t *Api) SendAudioCtx(ctx context.Context, c *types.SendAudio) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -311,13 +381,18 @@ func (t *Api) SendAudio(c *types.SendAudio) (*types.Message, error) {
 		return nil, errors.New("audio Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendDocument Use this method to send general files.
 // On success, the sent Message is returned.
 // Bots can currently send files of any type of up to 50 MB in size, this limit may be changed in the future.
 func (t *Api) SendDocument(c *types.SendDocument) (*types.Message, error) {
+	return t.SendDocumentCtx(context.Background(), c)
+}
+
+// SendDocumentCtx is the context-aware variant of SendDocument.
+func (t *Api) SendDocumentCtx(ctx context.Context, c *types.SendDocument) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -325,7 +400,7 @@ func (t *Api) SendDocument(c *types.SendDocument) (*types.Message, error) {
 		return nil, errors.New("document Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendVideo Use this method to send video files,
@@ -333,6 +408,11 @@ func (t *Api) SendDocument(c *types.SendDocument) (*types.Message, error) {
 // On success, the sent Message is returned.
 // Bots can currently send video files of up to 50 MB in size, this limit may be changed in the future.
 func (t *Api) SendVideo(c *types.SendVideo) (*types.Message, error) {
+	return t.SendVideoCtx(context.Background(), c)
+}
+
+// SendVideoCtx is the context-aware variant of SendVideo.
+func (t *Api) SendVideoCtx(ctx context.Context, c *types.SendVideo) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -340,13 +420,18 @@ func (t *Api) SendVideo(c *types.SendVideo) (*types.Message, error) {
 		return nil, errors.New("video Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendAnimation Use this method to send animation files (GIF or H.264/MPEG-4 AVC video without a sound).
 // On success, the sent Message is returned.
 // Bots can currently send animation files of up to 50 MB in size, this limit may be changed in the future.
 func (t *Api) SendAnimation(c *types.SendAnimation) (*types.Message, error) {
+	return t.SendAnimationCtx(context.Background(), c)
+}
+
+// SendAnimationCtx is the context-aware variant of SendAnimation.
+func (t *Api) SendAnimationCtx(ctx context.Context, c *types.SendAnimation) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -354,7 +439,7 @@ func (t *Api) SendAnimation(c *types.SendAnimation) (*types.Message, error) {
 		return nil, errors.New("animation Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendVoice Use this method
@@ -364,6 +449,11 @@ func (t *Api) SendAnimation(c *types.SendAnimation) (*types.Message, error) {
 // On success, the sent Message is returned.
 // Bots can currently send voice messages of up to 50 MB in size, this limit may be changed in the future.
 func (t *Api) SendVoice(c *types.SendVoice) (*types.Message, error) {
+	return t.SendVoiceCtx(context.Background(), c)
+}
+
+// SendVoiceCtx is the context-aware variant of SendVoice.
+func (t *Api) SendVoiceCtx(ctx context.Context, c *types.SendVoice) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -371,13 +461,18 @@ func (t *Api) SendVoice(c *types.SendVoice) (*types.Message, error) {
 		return nil, errors.New("voice Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendVideoNote As of v.4.0, Telegram clients support rounded square MPEG4 videos of up to 1 minute long.
 // Use this method to send video messages.
 // On success, the sent Message is returned.
 func (t *Api) SendVideoNote(c *types.SendVideoNote) (*types.Message, error) {
+	return t.SendVideoNoteCtx(context.Background(), c)
+}
+
+// SendVideoNoteCtx is the context-aware variant of SendVideoNote.
+func (t *Api) SendVideoNoteCtx(ctx context.Context, c *types.SendVideoNote) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -385,12 +480,17 @@ func (t *Api) SendVideoNote(c *types.SendVideoNote) (*types.Message, error) {
 		return nil, errors.New("VideoNote Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendPaidMedia send paid media to channel chats.
 // On success, the sent Message is returned.
 func (t *Api) SendPaidMedia(c *types.SendPaidMedia) (*types.Message, error) {
+	return t.SendPaidMediaCtx(context.Background(), c)
+}
+
+// SendPaidMediaCtx is the context-aware variant of SendPaidMedia.
+func (t *Api) SendPaidMediaCtx(ctx context.Context, c *types.SendPaidMedia) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -401,13 +501,18 @@ func (t *Api) SendPaidMedia(c *types.SendPaidMedia) (*types.Message, error) {
 		return nil, errors.New("media Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendMediaGroup Use this method to send a group of photos, videos, documents or audios as an album.
 // Documents and audio files can be only grouped on an album with messages of the same type.
 // On success, an array of Messages that were sent is returned.
 func (t *Api) SendMediaGroup(c *types.SendMediaGroup) ([]types.Message, error) {
+	return t.SendMediaGroupCtx(context.Background(), c)
+}
+
+// SendMediaGroupCtx is the context-aware variant of SendMediaGroup.
+func (t *Api) SendMediaGroupCtx(ctx context.Context, c *types.SendMediaGroup) ([]types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -415,19 +520,16 @@ func (t *Api) SendMediaGroup(c *types.SendMediaGroup) ([]types.Message, error) {
 		return nil, errors.New("media Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var messages []types.Message
-	err = json.Unmarshal(resp.Result, &messages)
-
-	return messages, err
+	return Call[[]types.Message](ctx, t, c)
 }
 
 // SendLocation Use this method to send point on the map. On success, the sent Message is returned.
 func (t *Api) SendLocation(c *types.SendLocation) (*types.Message, error) {
+	return t.SendLocationCtx(context.Background(), c)
+}
+
+// SendLocationCtx is the context-aware variant of SendLocation.
+func (t *Api) SendLocationCtx(ctx context.Context, c *types.SendLocation) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -438,7 +540,7 @@ func (t *Api) SendLocation(c *types.SendLocation) (*types.Message, error) {
 		return nil, errors.New("longitude Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // EditMessageLiveLocation Use this method to edit live location messages.
@@ -447,6 +549,11 @@ func (t *Api) SendLocation(c *types.SendLocation) (*types.Message, error) {
 // On success, if the edited message is not an inline message, the edited Message is returned;
 // otherwise True is returned.
 func (t *Api) EditMessageLiveLocation(c *types.EditMessageLiveLocation) (*types.Message, error) {
+	return t.EditMessageLiveLocationCtx(context.Background(), c)
+}
+
+// EditMessageLiveLocationCtx is the context-aware variant of EditMessageLiveLocation.
+func (t *Api) EditMessageLiveLocationCtx(ctx context.Context, c *types.EditMessageLiveLocation) (*types.Message, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return nil, errors.New("ChatID or Username Required")
@@ -462,12 +569,17 @@ func (t *Api) EditMessageLiveLocation(c *types.EditMessageLiveLocation) (*types.
 		return nil, errors.New("longitude Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // StopMessageLiveLocation Use this method to stop updating a live location message before live_period expires.
 // On success, if the message is not an inline message, the edited Message is returned, otherwise True is returned.
 func (t *Api) StopMessageLiveLocation(c *types.StopMessageLiveLocation) (*types.Message, error) {
+	return t.StopMessageLiveLocationCtx(context.Background(), c)
+}
+
+// StopMessageLiveLocationCtx is the context-aware variant of StopMessageLiveLocation.
+func (t *Api) StopMessageLiveLocationCtx(ctx context.Context, c *types.StopMessageLiveLocation) (*types.Message, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return nil, errors.New("ChatID or Username Required")
@@ -477,11 +589,16 @@ func (t *Api) StopMessageLiveLocation(c *types.StopMessageLiveLocation) (*types.
 		}
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendVenue Use this method to send information about a venue. On success, the sent Message is returned.
 func (t *Api) SendVenue(c *types.SendVenue) (*types.Message, error) {
+	return t.SendVenueCtx(context.Background(), c)
+}
+
+// SendVenueCtx is the context-aware variant of SendVenue.
+func (t *Api) SendVenueCtx(ctx context.Context, c *types.SendVenue) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -498,11 +615,16 @@ func (t *Api) SendVenue(c *types.SendVenue) (*types.Message, error) {
 		return nil, errors.New("address Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendContact Use this method to send phone contacts. On success, the sent Message is returned.
 func (t *Api) SendContact(c *types.SendContact) (*types.Message, error) {
+	return t.SendContactCtx(context.Background(), c)
+}
+
+// SendContactCtx is the context-aware variant of SendContact.
+func (t *Api) SendContactCtx(ctx context.Context, c *types.SendContact) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -513,11 +635,16 @@ func (t *Api) SendContact(c *types.SendContact) (*types.Message, error) {
 		return nil, errors.New("FirstName Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendPoll Use this method to send a native poll. On success, the sent Message is returned.
 func (t *Api) SendPoll(c *types.SendPoll) (*types.Message, error) {
+	return t.SendPollCtx(context.Background(), c)
+}
+
+// SendPollCtx is the context-aware variant of SendPoll.
+func (t *Api) SendPollCtx(ctx context.Context, c *types.SendPoll) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -528,16 +655,21 @@ func (t *Api) SendPoll(c *types.SendPoll) (*types.Message, error) {
 		return nil, errors.New("options Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendDice Use this method to send an animated emoji that will display a random value. On success, the sent Message is returned.
 func (t *Api) SendDice(c *types.SendDice) (*types.Message, error) {
+	return t.SendDiceCtx(context.Background(), c)
+}
+
+// SendDiceCtx is the context-aware variant of SendDice.
+func (t *Api) SendDiceCtx(ctx context.Context, c *types.SendDice) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SendChatAction Use this method when you need to tell the user that something is happening on the bot side.
@@ -550,6 +682,11 @@ func (t *Api) SendDice(c *types.SendDice) (*types.Message, error) {
 // The user will see a “sending photo” status for the bot.
 // We only recommend using this method when a response from the bot will take a noticeable amount of time to arrive.
 func (t *Api) SendChatAction(c *types.SendChatAction) (bool, error) {
+	return t.SendChatActionCtx(context.Background(), c)
+}
+
+// SendChatActionCtx is the context-aware variant of SendChatAction.
+func (t *Api) SendChatActionCtx(ctx context.Context, c *types.SendChatAction) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -557,7 +694,7 @@ func (t *Api) SendChatAction(c *types.SendChatAction) (bool, error) {
 		return false, errors.New("action Required")
 	}
 
-	_, err := t.Request(c)
+	_, err := t.RequestCtx(ctx, c)
 	if err != nil {
 		return false, err
 	}
@@ -570,6 +707,11 @@ func (t *Api) SendChatAction(c *types.SendChatAction) (bool, error) {
 // In albums, bots must react to the first message.
 // Returns True on success.
 func (t *Api) SetMessageReaction(c *types.SetMessageReaction) (bool, error) {
+	return t.SetMessageReactionCtx(context.Background(), c)
+}
+
+// SetMessageReactionCtx is the context-aware variant of SetMessageReaction.
+func (t *Api) SetMessageReactionCtx(ctx context.Context, c *types.SetMessageReaction) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -577,7 +719,7 @@ func (t *Api) SetMessageReaction(c *types.SetMessageReaction) (bool, error) {
 		return false, errors.New("MessageID Required")
 	}
 
-	_, err := t.Request(c)
+	_, err := t.RequestCtx(ctx, c)
 	if err != nil {
 		return false, err
 	}
@@ -587,19 +729,16 @@ func (t *Api) SetMessageReaction(c *types.SetMessageReaction) (bool, error) {
 
 // GetUserProfilePhotos Use this method to get a list of profile pictures for a user. Returns a UserProfilePhotos object.
 func (t *Api) GetUserProfilePhotos(c *types.GetUserProfilePhotos) (*types.UserProfilePhotos, error) {
+	return t.GetUserProfilePhotosCtx(context.Background(), c)
+}
+
+// GetUserProfilePhotosCtx is the context-aware variant of GetUserProfilePhotos.
+func (t *Api) GetUserProfilePhotosCtx(ctx context.Context, c *types.GetUserProfilePhotos) (*types.UserProfilePhotos, error) {
 	if c.UserID == 0 {
 		return nil, errors.New("UserID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var profilePhotos types.UserProfilePhotos
-	err = json.Unmarshal(resp.Result, &profilePhotos)
-
-	return &profilePhotos, err
+	return Call[*types.UserProfilePhotos](ctx, t, c)
 }
 
 // GetFile Use this method to get basic information about a file and prepare it for downloading.
@@ -612,19 +751,16 @@ func (t *Api) GetUserProfilePhotos(c *types.GetUserProfilePhotos) (*types.UserPr
 // Note: This function may not preserve the original file name and MIME type.
 // You should save the file's MIME type and name (if available) when the File object is received.
 func (t *Api) GetFile(c *types.GetFile) (*types.File, error) {
+	return t.GetFileCtx(context.Background(), c)
+}
+
+// GetFileCtx is the context-aware variant of GetFile.
+func (t *Api) GetFileCtx(ctx context.Context, c *types.GetFile) (*types.File, error) {
 	if c.FileID == "" {
 		return nil, errors.New("FileID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var file types.File
-	err = json.Unmarshal(resp.Result, &file)
-
-	return &file, err
+	return Call[*types.File](ctx, t, c)
 }
 
 // BanChatMember Use this method to ban a user in a group, a supergroup or a channel.
@@ -634,6 +770,11 @@ func (t *Api) GetFile(c *types.GetFile) (*types.File, error) {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True to success.
 func (t *Api) BanChatMember(c *types.BanChatMember) (bool, error) {
+	return t.BanChatMemberCtx(context.Background(), c)
+}
+
+// BanChatMemberCtx is the context-aware variant of BanChatMember.
+func (t *Api) BanChatMemberCtx(ctx context.Context, c *types.BanChatMember) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -641,18 +782,7 @@ func (t *Api) BanChatMember(c *types.BanChatMember) (bool, error) {
 		return false, errors.New("UserID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // UnbanChatMember Use this method to unban a previously banned user in a supergroup or channel.
@@ -664,6 +794,11 @@ func (t *Api) BanChatMember(c *types.BanChatMember) (bool, error) {
 // If you don't want this, use the parameter only_if_banned.
 // Returns True to success.
 func (t *Api) UnbanChatMember(c *types.UnbanChatMember) (bool, error) {
+	return t.UnbanChatMemberCtx(context.Background(), c)
+}
+
+// UnbanChatMemberCtx is the context-aware variant of UnbanChatMember.
+func (t *Api) UnbanChatMemberCtx(ctx context.Context, c *types.UnbanChatMember) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -671,18 +806,7 @@ func (t *Api) UnbanChatMember(c *types.UnbanChatMember) (bool, error) {
 		return false, errors.New("user_id Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // RestrictChatMember Use this method to restrict a user in a supergroup.
@@ -691,6 +815,11 @@ func (t *Api) UnbanChatMember(c *types.UnbanChatMember) (bool, error) {
 // Pass True for all permissions to lift restrictions from a user.
 // Returns True to success.
 func (t *Api) RestrictChatMember(c *types.RestrictChatMember) (bool, error) {
+	return t.RestrictChatMemberCtx(context.Background(), c)
+}
+
+// RestrictChatMemberCtx is the context-aware variant of RestrictChatMember.
+func (t *Api) RestrictChatMemberCtx(ctx context.Context, c *types.RestrictChatMember) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -698,18 +827,7 @@ func (t *Api) RestrictChatMember(c *types.RestrictChatMember) (bool, error) {
 		return false, errors.New("user_id Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // PromoteChatMember Use this method to promote or demote a user in a supergroup or a channel.
@@ -717,6 +835,11 @@ func (t *Api) RestrictChatMember(c *types.RestrictChatMember) (bool, error) {
 // Pass False for all boolean parameters to demote a user.
 // Returns True to success.
 func (t *Api) PromoteChatMember(c *types.PromoteChatMember) (bool, error) {
+	return t.PromoteChatMemberCtx(context.Background(), c)
+}
+
+// PromoteChatMemberCtx is the context-aware variant of PromoteChatMember.
+func (t *Api) PromoteChatMemberCtx(ctx context.Context, c *types.PromoteChatMember) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -724,24 +847,18 @@ func (t *Api) PromoteChatMember(c *types.PromoteChatMember) (bool, error) {
 		return false, errors.New("user_id Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetChatAdministratorCustomTitle Use this method
 // to set a custom title for an administrator in a supergroup promoted by the bot.
 // Returns True to success.
 func (t *Api) SetChatAdministratorCustomTitle(c *types.SetChatAdministratorCustomTitle) (bool, error) {
+	return t.SetChatAdministratorCustomTitleCtx(context.Background(), c)
+}
+
+// SetChatAdministratorCustomTitleCtx is the context-aware variant of SetChatAdministratorCustomTitle.
+func (t *Api) SetChatAdministratorCustomTitleCtx(ctx context.Context, c *types.SetChatAdministratorCustomTitle) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -752,18 +869,7 @@ func (t *Api) SetChatAdministratorCustomTitle(c *types.SetChatAdministratorCusto
 		return false, errors.New("custom_title Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // BanChatSenderChat Use this method to ban a channel chat in a supergroup or a channel.
@@ -773,6 +879,11 @@ func (t *Api) SetChatAdministratorCustomTitle(c *types.SetChatAdministratorCusto
 // and must have the appropriate administrator rights.
 // Returns True to success.
 func (t *Api) BanChatSenderChat(c *types.BanChatSenderChat) (bool, error) {
+	return t.BanChatSenderChatCtx(context.Background(), c)
+}
+
+// BanChatSenderChatCtx is the context-aware variant of BanChatSenderChat.
+func (t *Api) BanChatSenderChatCtx(ctx context.Context, c *types.BanChatSenderChat) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -780,24 +891,18 @@ func (t *Api) BanChatSenderChat(c *types.BanChatSenderChat) (bool, error) {
 		return false, errors.New("sender_chatID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // UnbanChatSenderChat Use this method to unban a previously banned channel chat in a supergroup or channel.
 // The bot must be an administrator for this to work and must have the appropriate administrator rights.
 // Returns True to success.
 func (t *Api) UnbanChatSenderChat(c *types.UnbanChatSenderChat) (bool, error) {
+	return t.UnbanChatSenderChatCtx(context.Background(), c)
+}
+
+// UnbanChatSenderChatCtx is the context-aware variant of UnbanChatSenderChat.
+func (t *Api) UnbanChatSenderChatCtx(ctx context.Context, c *types.UnbanChatSenderChat) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -805,18 +910,7 @@ func (t *Api) UnbanChatSenderChat(c *types.UnbanChatSenderChat) (bool, error) {
 		return false, errors.New("sender_chatID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetChatPermissions Use this method to set default chat permissions for all members.
@@ -824,22 +918,16 @@ func (t *Api) UnbanChatSenderChat(c *types.UnbanChatSenderChat) (bool, error) {
 // for this to work and must have the can_restrict_members administrator rights.
 // Returns True to success.
 func (t *Api) SetChatPermissions(c *types.SetChatPermissions) (bool, error) {
+	return t.SetChatPermissionsCtx(context.Background(), c)
+}
+
+// SetChatPermissionsCtx is the context-aware variant of SetChatPermissions.
+func (t *Api) SetChatPermissionsCtx(ctx context.Context, c *types.SetChatPermissions) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // ExportChatInviteLink Use this method to generate a new primary invite link for a chat;
@@ -847,19 +935,16 @@ func (t *Api) SetChatPermissions(c *types.SetChatPermissions) (bool, error) {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns the new invite link as String on success.
 func (t *Api) ExportChatInviteLink(c *types.ExportChatInviteLink) (string, error) {
+	return t.ExportChatInviteLinkCtx(context.Background(), c)
+}
+
+// ExportChatInviteLinkCtx is the context-aware variant of ExportChatInviteLink.
+func (t *Api) ExportChatInviteLinkCtx(ctx context.Context, c *types.ExportChatInviteLink) (string, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return "", errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return "", err
-	}
-
-	var inviteLink string
-	err = json.Unmarshal(resp.Result, &inviteLink)
-
-	return inviteLink, err
+	return Call[string](ctx, t, c)
 }
 
 // CreateChatInviteLink Use this method to create an additional invite link for a chat.
@@ -867,25 +952,27 @@ func (t *Api) ExportChatInviteLink(c *types.ExportChatInviteLink) (string, error
 // The link can be revoked using the method revokeChatInviteLink.
 // Returns the new invite link as ChatInviteLink object.
 func (t *Api) CreateChatInviteLink(c *types.CreateChatInviteLink) (*types.ChatInviteLink, error) {
+	return t.CreateChatInviteLinkCtx(context.Background(), c)
+}
+
+// CreateChatInviteLinkCtx is the context-aware variant of CreateChatInviteLink.
+func (t *Api) CreateChatInviteLinkCtx(ctx context.Context, c *types.CreateChatInviteLink) (*types.ChatInviteLink, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var chatInviteLink types.ChatInviteLink
-	err = json.Unmarshal(resp.Result, &chatInviteLink)
-
-	return &chatInviteLink, err
+	return Call[*types.ChatInviteLink](ctx, t, c)
 }
 
 // EditChatInviteLink Use this method to edit a non-primary invite link created by the bot.
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns the edited invite link as a ChatInviteLink object.
 func (t *Api) EditChatInviteLink(c *types.EditChatInviteLink) (*types.ChatInviteLink, error) {
+	return t.EditChatInviteLinkCtx(context.Background(), c)
+}
+
+// EditChatInviteLinkCtx is the context-aware variant of EditChatInviteLink.
+func (t *Api) EditChatInviteLinkCtx(ctx context.Context, c *types.EditChatInviteLink) (*types.ChatInviteLink, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -893,15 +980,7 @@ func (t *Api) EditChatInviteLink(c *types.EditChatInviteLink) (*types.ChatInvite
 		return nil, errors.New("invite_link Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var chatInviteLink types.ChatInviteLink
-	err = json.Unmarshal(resp.Result, &chatInviteLink)
-
-	return &chatInviteLink, err
+	return Call[*types.ChatInviteLink](ctx, t, c)
 }
 
 // RevokeChatInviteLink Use this method to revoke an invitation link created by the bot.
@@ -909,6 +988,11 @@ func (t *Api) EditChatInviteLink(c *types.EditChatInviteLink) (*types.ChatInvite
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns the revoked invite link as ChatInviteLink object.
 func (t *Api) RevokeChatInviteLink(c *types.RevokeChatInviteLink) (*types.ChatInviteLink, error) {
+	return t.RevokeChatInviteLinkCtx(context.Background(), c)
+}
+
+// RevokeChatInviteLinkCtx is the context-aware variant of RevokeChatInviteLink.
+func (t *Api) RevokeChatInviteLinkCtx(ctx context.Context, c *types.RevokeChatInviteLink) (*types.ChatInviteLink, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -916,21 +1000,18 @@ func (t *Api) RevokeChatInviteLink(c *types.RevokeChatInviteLink) (*types.ChatIn
 		return nil, errors.New("invite_link Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var chatInviteLink types.ChatInviteLink
-	err = json.Unmarshal(resp.Result, &chatInviteLink)
-
-	return &chatInviteLink, err
+	return Call[*types.ChatInviteLink](ctx, t, c)
 }
 
 // ApproveChatJoinRequest Use this method to approve a chat join request.
 // The bot must be an administrator in the chat for this to work and must have the can_invite_users administrator right.
 // Returns True to success.
 func (t *Api) ApproveChatJoinRequest(c *types.ApproveChatJoinRequest) (bool, error) {
+	return t.ApproveChatJoinRequestCtx(context.Background(), c)
+}
+
+// ApproveChatJoinRequestCtx is the context-aware variant of ApproveChatJoinRequest.
+func (t *Api) ApproveChatJoinRequestCtx(ctx context.Context, c *types.ApproveChatJoinRequest) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -938,24 +1019,18 @@ func (t *Api) ApproveChatJoinRequest(c *types.ApproveChatJoinRequest) (bool, err
 		return false, errors.New("user_id Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeclineChatJoinRequest Use this method to decline a chat join request.
 // The bot must be an administrator in the chat for this to work and must have the can_invite_users administrator right.
 // Returns True to success.
 func (t *Api) DeclineChatJoinRequest(c *types.DeclineChatJoinRequest) (bool, error) {
+	return t.DeclineChatJoinRequestCtx(context.Background(), c)
+}
+
+// DeclineChatJoinRequestCtx is the context-aware variant of DeclineChatJoinRequest.
+func (t *Api) DeclineChatJoinRequestCtx(ctx context.Context, c *types.DeclineChatJoinRequest) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -963,18 +1038,7 @@ func (t *Api) DeclineChatJoinRequest(c *types.DeclineChatJoinRequest) (bool, err
 		return false, errors.New("user_id Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetChatPhoto Use this method to set a new profile photo for the chat.
@@ -982,6 +1046,11 @@ func (t *Api) DeclineChatJoinRequest(c *types.DeclineChatJoinRequest) (bool, err
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True to success.
 func (t *Api) SetChatPhoto(c *types.SetChatPhoto) (bool, error) {
+	return t.SetChatPhotoCtx(context.Background(), c)
+}
+
+// SetChatPhotoCtx is the context-aware variant of SetChatPhoto.
+func (t *Api) SetChatPhotoCtx(ctx context.Context, c *types.SetChatPhoto) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -989,18 +1058,7 @@ func (t *Api) SetChatPhoto(c *types.SetChatPhoto) (bool, error) {
 		return false, errors.New("photo Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeleteChatPhoto Use this method to delete a chat photo.
@@ -1008,22 +1066,16 @@ func (t *Api) SetChatPhoto(c *types.SetChatPhoto) (bool, error) {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True to success.
 func (t *Api) DeleteChatPhoto(c *types.DeleteChatPhoto) (bool, error) {
+	return t.DeleteChatPhotoCtx(context.Background(), c)
+}
+
+// DeleteChatPhotoCtx is the context-aware variant of DeleteChatPhoto.
+func (t *Api) DeleteChatPhotoCtx(ctx context.Context, c *types.DeleteChatPhoto) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetChatTitle Use this method to change the title of a chat.
@@ -1031,6 +1083,11 @@ func (t *Api) DeleteChatPhoto(c *types.DeleteChatPhoto) (bool, error) {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True to success.
 func (t *Api) SetChatTitle(c *types.SetChatTitle) (bool, error) {
+	return t.SetChatTitleCtx(context.Background(), c)
+}
+
+// SetChatTitleCtx is the context-aware variant of SetChatTitle.
+func (t *Api) SetChatTitleCtx(ctx context.Context, c *types.SetChatTitle) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1038,40 +1095,23 @@ func (t *Api) SetChatTitle(c *types.SetChatTitle) (bool, error) {
 		return false, errors.New("title Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetChatDescription Use this method to change the description of a group, a supergroup or a channel.
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True to success.
 func (t *Api) SetChatDescription(c *types.SetChatDescription) (bool, error) {
+	return t.SetChatDescriptionCtx(context.Background(), c)
+}
+
+// SetChatDescriptionCtx is the context-aware variant of SetChatDescription.
+func (t *Api) SetChatDescriptionCtx(ctx context.Context, c *types.SetChatDescription) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // PinChatMessage Use this method to add a message to the list of pinned messages in a chat.
@@ -1080,6 +1120,11 @@ func (t *Api) SetChatDescription(c *types.SetChatDescription) (bool, error) {
 // administrator right in a supergroup or 'can_edit_messages' administrator right in a channel.
 // Returns True to success.
 func (t *Api) PinChatMessage(c *types.PinChatMessage) (bool, error) {
+	return t.PinChatMessageCtx(context.Background(), c)
+}
+
+// PinChatMessageCtx is the context-aware variant of PinChatMessage.
+func (t *Api) PinChatMessageCtx(ctx context.Context, c *types.PinChatMessage) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1087,18 +1132,7 @@ func (t *Api) PinChatMessage(c *types.PinChatMessage) (bool, error) {
 		return false, errors.New("MessageID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // UnpinChatMessage Use this method to remove a message from the list of pinned messages in a chat.
@@ -1107,22 +1141,16 @@ func (t *Api) PinChatMessage(c *types.PinChatMessage) (bool, error) {
 // administrator right in a supergroup or 'can_edit_messages' administrator right in a channel.
 // Returns True to success.
 func (t *Api) UnpinChatMessage(c *types.UnpinChatMessage) (bool, error) {
+	return t.UnpinChatMessageCtx(context.Background(), c)
+}
+
+// UnpinChatMessageCtx is the context-aware variant of UnpinChatMessage.
+func (t *Api) UnpinChatMessageCtx(ctx context.Context, c *types.UnpinChatMessage) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // UnpinAllChatMessages Use this method to clear the list of pinned messages in a chat.
@@ -1131,65 +1159,53 @@ func (t *Api) UnpinChatMessage(c *types.UnpinChatMessage) (bool, error) {
 // administrator right in a supergroup or 'can_edit_messages' administrator right in a channel.
 // Returns True to success.
 func (t *Api) UnpinAllChatMessages(c *types.UnpinAllChatMessages) (bool, error) {
+	return t.UnpinAllChatMessagesCtx(context.Background(), c)
+}
+
+// UnpinAllChatMessagesCtx is the context-aware variant of UnpinAllChatMessages.
+func (t *Api) UnpinAllChatMessagesCtx(ctx context.Context, c *types.UnpinAllChatMessages) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // LeaveChat Use this method for your bot to leave a group, supergroup or channel. Returns True to success.
 func (t *Api) LeaveChat(c *types.LeaveChat) (bool, error) {
+	return t.LeaveChatCtx(context.Background(), c)
+}
+
+// LeaveChatCtx is the context-aware variant of LeaveChat.
+func (t *Api) LeaveChatCtx(ctx context.Context, c *types.LeaveChat) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // GetChat Use this method to get up-to-date information about the chat
 // (current name of the user for one-on-one conversations, current username of a user, group or channel, etc.).
 // Returns a Chat object on success.
 func (t *Api) GetChat(c *types.GetChat) (*types.ChatFullInfo, error) {
+	return t.GetChatCtx(context.Background(), c)
+}
+
+// GetChatCtx is the context-aware variant of GetChat.
+func (t *Api) GetChatCtx(ctx context.Context, c *types.GetChat) (*types.ChatFullInfo, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
+	chat, err := Call[*types.ChatFullInfo](ctx, t, c)
 	if err != nil {
 		return nil, err
 	}
 
-	var chat types.ChatFullInfo
-	err = json.Unmarshal(resp.Result, &chat)
-
-	if err == nil {
-		chat.IDString = fmt.Sprintf("%d", chat.ID)
-	}
+	chat.IDString = fmt.Sprintf("%d", chat.ID)
 
-	return &chat, err
+	return chat, nil
 }
 
 // GetChatAdministrators Use this method to get a list of administrators in a chat.
@@ -1197,40 +1213,39 @@ func (t *Api) GetChat(c *types.GetChat) (*types.ChatFullInfo, error) {
 // returns an Array of ChatMember objects that contains information about all chat administrators except other bots.
 // If the chat is a group or a supergroup and no administrators were appointed, only the creator will be returned.
 func (t *Api) GetChatAdministrators(c *types.GetChatAdministrators) ([]types.ChatMember, error) {
+	return t.GetChatAdministratorsCtx(context.Background(), c)
+}
+
+// GetChatAdministratorsCtx is the context-aware variant of GetChatAdministrators.
+func (t *Api) GetChatAdministratorsCtx(ctx context.Context, c *types.GetChatAdministrators) ([]types.ChatMember, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var members []types.ChatMember
-	err = json.Unmarshal(resp.Result, &members)
-
-	return members, err
+	return Call[[]types.ChatMember](ctx, t, c)
 }
 
 // GetChatMemberCount Use this method to get the number of members in a chat. Returns Int to success.
 func (t *Api) GetChatMemberCount(c *types.GetChatMemberCount) (int64, error) {
+	return t.GetChatMemberCountCtx(context.Background(), c)
+}
+
+// GetChatMemberCountCtx is the context-aware variant of GetChatMemberCount.
+func (t *Api) GetChatMemberCountCtx(ctx context.Context, c *types.GetChatMemberCount) (int64, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return 0, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return 0, err
-	}
-
-	var count int64
-	err = json.Unmarshal(resp.Result, &count)
-
-	return count, err
+	return Call[int64](ctx, t, c)
 }
 
 // GetChatMember Use this method to get information about a member of a chat. Returns a ChatMember object on success.
 func (t *Api) GetChatMember(c *types.GetChatMember) (*types.ChatMember, error) {
+	return t.GetChatMemberCtx(context.Background(), c)
+}
+
+// GetChatMemberCtx is the context-aware variant of GetChatMember.
+func (t *Api) GetChatMemberCtx(ctx context.Context, c *types.GetChatMember) (*types.ChatMember, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -1238,15 +1253,7 @@ func (t *Api) GetChatMember(c *types.GetChatMember) (*types.ChatMember, error) {
 		return nil, errors.New("UserID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var member types.ChatMember
-	err = json.Unmarshal(resp.Result, &member)
-
-	return &member, err
+	return Call[*types.ChatMember](ctx, t, c)
 }
 
 // SetChatStickerSet Use this method to set a new group sticker set for a supergroup.
@@ -1254,6 +1261,11 @@ func (t *Api) GetChatMember(c *types.GetChatMember) (*types.ChatMember, error) {
 // Use the field can_set_sticker_set optionally returned in getChat requests to check if the bot can use this method.
 // Returns True to success.
 func (t *Api) SetChatStickerSet(c *types.SetChatStickerSet) (bool, error) {
+	return t.SetChatStickerSetCtx(context.Background(), c)
+}
+
+// SetChatStickerSetCtx is the context-aware variant of SetChatStickerSet.
+func (t *Api) SetChatStickerSetCtx(ctx context.Context, c *types.SetChatStickerSet) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1261,18 +1273,7 @@ func (t *Api) SetChatStickerSet(c *types.SetChatStickerSet) (bool, error) {
 		return false, errors.New("StickerSetName Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeleteChatStickerSet Use this method to delete a group sticker set from a supergroup.
@@ -1280,22 +1281,16 @@ func (t *Api) SetChatStickerSet(c *types.SetChatStickerSet) (bool, error) {
 // Use the field can_set_sticker_set optionally returned in getChat requests to check if the bot can use this method.
 // Returns True to success.
 func (t *Api) DeleteChatStickerSet(c *types.DeleteChatStickerSet) (bool, error) {
+	return t.DeleteChatStickerSetCtx(context.Background(), c)
+}
+
+// DeleteChatStickerSetCtx is the context-aware variant of DeleteChatStickerSet.
+func (t *Api) DeleteChatStickerSetCtx(ctx context.Context, c *types.DeleteChatStickerSet) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // GetForumTopicIconStickers Use this method to get custom emoji stickers,
@@ -1303,18 +1298,12 @@ func (t *Api) DeleteChatStickerSet(c *types.DeleteChatStickerSet) (bool, error)
 // Requires no parameters.
 // Returns an Array of Sticker objects.
 func (t *Api) GetForumTopicIconStickers(c *types.GetForumTopicIconStickers) ([]types.Sticker, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []types.Sticker
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return nil, err
-	}
+	return t.GetForumTopicIconStickersCtx(context.Background(), c)
+}
 
-	return result, err
+// GetForumTopicIconStickersCtx is the context-aware variant of GetForumTopicIconStickers.
+func (t *Api) GetForumTopicIconStickersCtx(ctx context.Context, c *types.GetForumTopicIconStickers) ([]types.Sticker, error) {
+	return Call[[]types.Sticker](ctx, t, c)
 }
 
 // CreateForumTopic Use this method to create a topic in a forum supergroup chat.
@@ -1322,6 +1311,11 @@ func (t *Api) GetForumTopicIconStickers(c *types.GetForumTopicIconStickers) ([]t
 // and must have the can_manage_topics administrator rights.
 // Returns information about the created topic as a ForumTopic object.
 func (t *Api) CreateForumTopic(c *types.CreateForumTopic) (*types.ForumTopic, error) {
+	return t.CreateForumTopicCtx(context.Background(), c)
+}
+
+// CreateForumTopicCtx is the context-aware variant of CreateForumTopic.
+func (t *Api) CreateForumTopicCtx(ctx context.Context, c *types.CreateForumTopic) (*types.ForumTopic, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -1330,18 +1324,7 @@ func (t *Api) CreateForumTopic(c *types.CreateForumTopic) (*types.ForumTopic, er
 		return nil, errors.New("name is Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var result types.ForumTopic
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, err
+	return Call[*types.ForumTopic](ctx, t, c)
 }
 
 // EditForumTopic Use this method to edit the name and icon of a topic in a forum supergroup chat.
@@ -1349,6 +1332,11 @@ func (t *Api) CreateForumTopic(c *types.CreateForumTopic) (*types.ForumTopic, er
 // unless it is the creator of the topic.
 // Returns True to success.
 func (t *Api) EditForumTopic(c *types.EditForumTopic) (bool, error) {
+	return t.EditForumTopicCtx(context.Background(), c)
+}
+
+// EditForumTopicCtx is the context-aware variant of EditForumTopic.
+func (t *Api) EditForumTopicCtx(ctx context.Context, c *types.EditForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1357,18 +1345,7 @@ func (t *Api) EditForumTopic(c *types.EditForumTopic) (bool, error) {
 		return false, errors.New("message_thread_id is Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // CloseForumTopic Use this method to close an open topic in a forum supergroup chat.
@@ -1377,6 +1354,11 @@ func (t *Api) EditForumTopic(c *types.EditForumTopic) (bool, error) {
 // unless it is the creator of the topic.
 // Returns True to success.
 func (t *Api) CloseForumTopic(c *types.CloseForumTopic) (bool, error) {
+	return t.CloseForumTopicCtx(context.Background(), c)
+}
+
+// CloseForumTopicCtx is the context-aware variant of CloseForumTopic.
+func (t *Api) CloseForumTopicCtx(ctx context.Context, c *types.CloseForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1385,18 +1367,7 @@ func (t *Api) CloseForumTopic(c *types.CloseForumTopic) (bool, error) {
 		return false, errors.New("message_thread_id is Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // ReopenForumTopic Use this method to reopen a closed topic in a forum supergroup chat.
@@ -1405,6 +1376,11 @@ func (t *Api) CloseForumTopic(c *types.CloseForumTopic) (bool, error) {
 // unless it is the creator of the topic.
 // Returns True to success.
 func (t *Api) ReopenForumTopic(c *types.ReopenForumTopic) (bool, error) {
+	return t.ReopenForumTopicCtx(context.Background(), c)
+}
+
+// ReopenForumTopicCtx is the context-aware variant of ReopenForumTopic.
+func (t *Api) ReopenForumTopicCtx(ctx context.Context, c *types.ReopenForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1413,18 +1389,7 @@ func (t *Api) ReopenForumTopic(c *types.ReopenForumTopic) (bool, error) {
 		return false, errors.New("message_thread_id is Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeleteForumTopic Use this method to delete a forum topic along with all its messages in a forum supergroup chat.
@@ -1432,6 +1397,11 @@ func (t *Api) ReopenForumTopic(c *types.ReopenForumTopic) (bool, error) {
 // and must have the can_delete_messages administrator rights.
 // Returns True to success.
 func (t *Api) DeleteForumTopic(c *types.DeleteForumTopic) (bool, error) {
+	return t.DeleteForumTopicCtx(context.Background(), c)
+}
+
+// DeleteForumTopicCtx is the context-aware variant of DeleteForumTopic.
+func (t *Api) DeleteForumTopicCtx(ctx context.Context, c *types.DeleteForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1440,18 +1410,7 @@ func (t *Api) DeleteForumTopic(c *types.DeleteForumTopic) (bool, error) {
 		return false, errors.New("message_thread_id is Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // UnpinAllForumTopicMessages Use this method to clear the list of pinned messages in a forum topic.
@@ -1460,6 +1419,11 @@ func (t *Api) DeleteForumTopic(c *types.DeleteForumTopic) (bool, error) {
 // Returns True to
 // success.
 func (t *Api) UnpinAllForumTopicMessages(c *types.UnpinAllForumTopicMessages) (bool, error) {
+	return t.UnpinAllForumTopicMessagesCtx(context.Background(), c)
+}
+
+// UnpinAllForumTopicMessagesCtx is the context-aware variant of UnpinAllForumTopicMessages.
+func (t *Api) UnpinAllForumTopicMessagesCtx(ctx context.Context, c *types.UnpinAllForumTopicMessages) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1468,24 +1432,18 @@ func (t *Api) UnpinAllForumTopicMessages(c *types.UnpinAllForumTopicMessages) (b
 		return false, errors.New("message_thread_id is Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // EditGeneralForumTopic Use this method to edit the name of the 'General' topic in a forum supergroup chat.
 // The bot must be an administrator in the chat for this to work and must have can_manage_topics administrator rights.
 // Returns True to success.
 func (t *Api) EditGeneralForumTopic(c *types.EditGeneralForumTopic) (bool, error) {
+	return t.EditGeneralForumTopicCtx(context.Background(), c)
+}
+
+// EditGeneralForumTopicCtx is the context-aware variant of EditGeneralForumTopic.
+func (t *Api) EditGeneralForumTopicCtx(ctx context.Context, c *types.EditGeneralForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -1494,18 +1452,7 @@ func (t *Api) EditGeneralForumTopic(c *types.EditGeneralForumTopic) (bool, error
 		return false, errors.New("name is Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // CloseGeneralForumTopic Use this method to close an open 'General' topic in a
@@ -1514,22 +1461,16 @@ func (t *Api) EditGeneralForumTopic(c *types.EditGeneralForumTopic) (bool, error
 // and must have the can_manage_topics administrator rights.
 // Returns True to success.
 func (t *Api) CloseGeneralForumTopic(c *types.CloseGeneralForumTopic) (bool, error) {
+	return t.CloseGeneralForumTopicCtx(context.Background(), c)
+}
+
+// CloseGeneralForumTopicCtx is the context-aware variant of CloseGeneralForumTopic.
+func (t *Api) CloseGeneralForumTopicCtx(ctx context.Context, c *types.CloseGeneralForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // ReopenGeneralForumTopic Use this method to reopen a closed 'General' topic in a forum supergroup chat.
@@ -1538,22 +1479,16 @@ func (t *Api) CloseGeneralForumTopic(c *types.CloseGeneralForumTopic) (bool, err
 // The topic will be automatically unhidden if it was hidden.
 // Returns True to success.
 func (t *Api) ReopenGeneralForumTopic(c *types.ReopenGeneralForumTopic) (bool, error) {
+	return t.ReopenGeneralForumTopicCtx(context.Background(), c)
+}
+
+// ReopenGeneralForumTopicCtx is the context-aware variant of ReopenGeneralForumTopic.
+func (t *Api) ReopenGeneralForumTopicCtx(ctx context.Context, c *types.ReopenGeneralForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // HideGeneralForumTopic Use this method to hide the 'General' topic in a forum supergroup chat.
@@ -1562,22 +1497,16 @@ func (t *Api) ReopenGeneralForumTopic(c *types.ReopenGeneralForumTopic) (bool, e
 // The topic will be automatically closed if it is open.
 // Returns True to success.
 func (t *Api) HideGeneralForumTopic(c *types.HideGeneralForumTopic) (bool, error) {
+	return t.HideGeneralForumTopicCtx(context.Background(), c)
+}
+
+// HideGeneralForumTopicCtx is the context-aware variant of HideGeneralForumTopic.
+func (t *Api) HideGeneralForumTopicCtx(ctx context.Context, c *types.HideGeneralForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // UnHideGeneralForumTopic Use this method to unhide the 'General' topic in a forum supergroup chat.
@@ -1585,22 +1514,16 @@ func (t *Api) HideGeneralForumTopic(c *types.HideGeneralForumTopic) (bool, error
 // and must have the can_manage_topics administrator rights.
 // Returns True to success.
 func (t *Api) UnHideGeneralForumTopic(c *types.UnHideGeneralForumTopic) (bool, error) {
+	return t.UnHideGeneralForumTopicCtx(context.Background(), c)
+}
+
+// UnHideGeneralForumTopicCtx is the context-aware variant of UnHideGeneralForumTopic.
+func (t *Api) UnHideGeneralForumTopicCtx(ctx context.Context, c *types.UnHideGeneralForumTopic) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // UnpinAllGeneralForumTopicMessages Use this method to clear the list of pinned messages in a General forum topic.
@@ -1608,22 +1531,16 @@ func (t *Api) UnHideGeneralForumTopic(c *types.UnHideGeneralForumTopic) (bool, e
 // and must have the can_pin_messages administrator right in the supergroup.
 // Returns True to success.
 func (t *Api) UnpinAllGeneralForumTopicMessages(c *types.UnpinAllGeneralForumTopicMessages) (bool, error) {
+	return t.UnpinAllGeneralForumTopicMessagesCtx(context.Background(), c)
+}
+
+// UnpinAllGeneralForumTopicMessagesCtx is the context-aware variant of UnpinAllGeneralForumTopicMessages.
+func (t *Api) UnpinAllGeneralForumTopicMessagesCtx(ctx context.Context, c *types.UnpinAllGeneralForumTopicMessages) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // AnswerCallbackQuery Use this method to send answers to callback queries sent from inline keyboards.
@@ -1633,26 +1550,25 @@ func (t *Api) UnpinAllGeneralForumTopicMessages(c *types.UnpinAllGeneralForumTop
 // For this option to work, you must first create a game for your bot via @BotFather and accept the terms.
 // Otherwise, you may use links like t.me/your_bot?start=XXXX that open your bot with a parameter.
 func (t *Api) AnswerCallbackQuery(c *types.AnswerCallbackQuery) (bool, error) {
+	return t.AnswerCallbackQueryCtx(context.Background(), c)
+}
+
+// AnswerCallbackQueryCtx is the context-aware variant of AnswerCallbackQuery.
+func (t *Api) AnswerCallbackQueryCtx(ctx context.Context, c *types.AnswerCallbackQuery) (bool, error) {
 	if c.CallbackQueryID == "" {
 		return false, errors.New("CallbackQueryID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // GetUserChatBoosts Use this method to get the list of boosts added to a chat by a user. Requires administrator rights in the chat. Returns a UserChatBoosts object.
 func (t *Api) GetUserChatBoosts(c *types.GetUserChatBoosts) (*types.UserChatBoosts, error) {
+	return t.GetUserChatBoostsCtx(context.Background(), c)
+}
+
+// GetUserChatBoostsCtx is the context-aware variant of GetUserChatBoosts.
+func (t *Api) GetUserChatBoostsCtx(ctx context.Context, c *types.GetUserChatBoosts) (*types.UserChatBoosts, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -1660,217 +1576,152 @@ func (t *Api) GetUserChatBoosts(c *types.GetUserChatBoosts) (*types.UserChatBoos
 		return nil, errors.New("UserID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var chatBoosts types.UserChatBoosts
-	err = json.Unmarshal(resp.Result, &chatBoosts)
-
-	return &chatBoosts, err
+	return Call[*types.UserChatBoosts](ctx, t, c)
 }
 
 // GetBusinessConnection Use this method to get information about the connection of the bot with a business account.
 // Returns a BusinessConnection object on success.
 func (t *Api) GetBusinessConnection(c *types.GetBusinessConnection) (*types.BusinessConnection, error) {
+	return t.GetBusinessConnectionCtx(context.Background(), c)
+}
+
+// GetBusinessConnectionCtx is the context-aware variant of GetBusinessConnection.
+func (t *Api) GetBusinessConnectionCtx(ctx context.Context, c *types.GetBusinessConnection) (*types.BusinessConnection, error) {
 	if c.BusinessConnectionId == "" {
 		return nil, errors.New("BusinessConnectionId Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var businessConnection types.BusinessConnection
-	err = json.Unmarshal(resp.Result, &businessConnection)
-
-	return &businessConnection, err
+	return Call[*types.BusinessConnection](ctx, t, c)
 }
 
 // SetMyCommands Use this method to change the list of the bot commands.
 // See https://core.telegram.org/bots#commands for more details about bot commands.
 // Returns True to success.
 func (t *Api) SetMyCommands(c *types.SetMyCommands) (bool, error) {
+	return t.SetMyCommandsCtx(context.Background(), c)
+}
+
+// SetMyCommandsCtx is the context-aware variant of SetMyCommands.
+func (t *Api) SetMyCommandsCtx(ctx context.Context, c *types.SetMyCommands) (bool, error) {
 	if c.Commands == nil {
 		return false, errors.New("commands Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeleteMyCommands Use this method to change the list of the bot commands.
 // See https://core.telegram.org/bots#commands for more details about bot commands.
 // Returns True to success.
 func (t *Api) DeleteMyCommands(c *types.DeleteMyCommands) (bool, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+	return t.DeleteMyCommandsCtx(context.Background(), c)
+}
 
-	return result, err
+// DeleteMyCommandsCtx is the context-aware variant of DeleteMyCommands.
+func (t *Api) DeleteMyCommandsCtx(ctx context.Context, c *types.DeleteMyCommands) (bool, error) {
+	return Call[bool](ctx, t, c)
 }
 
 // GetMyCommands Use this method to get the current list of the bot commands for the given scope and user language.
 // Returns Array of BotCommand on success.
 // If commands aren't set, an empty list is returned.
 func (t *Api) GetMyCommands(c *types.GetMyCommands) ([]types.BotCommand, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var commands []types.BotCommand
-	err = json.Unmarshal(resp.Result, &commands)
+	return t.GetMyCommandsCtx(context.Background(), c)
+}
 
-	return commands, err
+// GetMyCommandsCtx is the context-aware variant of GetMyCommands.
+func (t *Api) GetMyCommandsCtx(ctx context.Context, c *types.GetMyCommands) ([]types.BotCommand, error) {
+	return Call[[]types.BotCommand](ctx, t, c)
 }
 
 // SetMyName Use this method to change the bot name.
 // Returns True to success.
 func (t *Api) SetMyName(c *types.SetMyName) (bool, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
+	return t.SetMyNameCtx(context.Background(), c)
+}
 
-	return result, err
+// SetMyNameCtx is the context-aware variant of SetMyName.
+func (t *Api) SetMyNameCtx(ctx context.Context, c *types.SetMyName) (bool, error) {
+	return Call[bool](ctx, t, c)
 }
 
 // GetMyName Use this method to get the current bot name for the given user language. Returns BotName on success.
 func (t *Api) GetMyName(c *types.GetMyName) (*types.BotName, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var result types.BotName
-	err = json.Unmarshal(resp.Result, &result)
+	return t.GetMyNameCtx(context.Background(), c)
+}
 
-	return &result, err
+// GetMyNameCtx is the context-aware variant of GetMyName.
+func (t *Api) GetMyNameCtx(ctx context.Context, c *types.GetMyName) (*types.BotName, error) {
+	return Call[*types.BotName](ctx, t, c)
 }
 
 // SetMyDescription Use this method to change the bot description,
 // which is shown in the chat with the bot if the chat is empty.
 // Returns True to success.
 func (t *Api) SetMyDescription(c *types.SetMyDescription) (bool, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+	return t.SetMyDescriptionCtx(context.Background(), c)
+}
 
-	return result, err
+// SetMyDescriptionCtx is the context-aware variant of SetMyDescription.
+func (t *Api) SetMyDescriptionCtx(ctx context.Context, c *types.SetMyDescription) (bool, error) {
+	return Call[bool](ctx, t, c)
 }
 
 // GetMyDescription Use this method to get the current bot description for the given user language.
 // Returns BotDescription on success.
 func (t *Api) GetMyDescription(c *types.GetMyDescription) (*types.BotDescription, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var result types.BotDescription
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return nil, err
-	}
+	return t.GetMyDescriptionCtx(context.Background(), c)
+}
 
-	return &result, err
+// GetMyDescriptionCtx is the context-aware variant of GetMyDescription.
+func (t *Api) GetMyDescriptionCtx(ctx context.Context, c *types.GetMyDescription) (*types.BotDescription, error) {
+	return Call[*types.BotDescription](ctx, t, c)
 }
 
 // SetMyShortDescription Use this method to change the bot short description,
 // which is shown on the bot profile page and is sent together with the link when users share the bot.
 // Returns True to success.
 func (t *Api) SetMyShortDescription(c *types.SetMyShortDescription) (bool, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+	return t.SetMyShortDescriptionCtx(context.Background(), c)
+}
 
-	return result, err
+// SetMyShortDescriptionCtx is the context-aware variant of SetMyShortDescription.
+func (t *Api) SetMyShortDescriptionCtx(ctx context.Context, c *types.SetMyShortDescription) (bool, error) {
+	return Call[bool](ctx, t, c)
 }
 
 // GetMyShortDescription Use this method to get the current bot short description for the given user language.
 // Returns BotShortDescription on success.
 func (t *Api) GetMyShortDescription(c *types.GetMyShortDescription) (*types.BotShortDescription, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var result types.BotShortDescription
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return nil, err
-	}
+	return t.GetMyShortDescriptionCtx(context.Background(), c)
+}
 
-	return &result, err
+// GetMyShortDescriptionCtx is the context-aware variant of GetMyShortDescription.
+func (t *Api) GetMyShortDescriptionCtx(ctx context.Context, c *types.GetMyShortDescription) (*types.BotShortDescription, error) {
+	return Call[*types.BotShortDescription](ctx, t, c)
 }
 
 // SetChatMenuButton Use this method to change the bot menu button in a private chat, or the default menu button.
 // Returns True to success.
 func (t *Api) SetChatMenuButton(c *types.SetChatMenuButton) (bool, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+	return t.SetChatMenuButtonCtx(context.Background(), c)
+}
 
-	return result, err
+// SetChatMenuButtonCtx is the context-aware variant of SetChatMenuButton.
+func (t *Api) SetChatMenuButtonCtx(ctx context.Context, c *types.SetChatMenuButton) (bool, error) {
+	return Call[bool](ctx, t, c)
 }
 
 // GetChatMenuButton Use this method to get the current value of the bot menu button in a private chat,
 // or the default menu button.
 // Returns MenuButton on success.
 func (t *Api) GetChatMenuButton(c *types.GetChatMenuButton) (*types.MenuButtons, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var menuButton types.MenuButtons
-	err = json.Unmarshal(resp.Result, &menuButton)
+	return t.GetChatMenuButtonCtx(context.Background(), c)
+}
 
-	return &menuButton, err
+// GetChatMenuButtonCtx is the context-aware variant of GetChatMenuButton.
+func (t *Api) GetChatMenuButtonCtx(ctx context.Context, c *types.GetChatMenuButton) (*types.MenuButtons, error) {
+	return Call[*types.MenuButtons](ctx, t, c)
 }
 
 // SetMyDefaultAdministratorRights Use this method to change the default administrator rights requested by the bot
@@ -1878,41 +1729,34 @@ func (t *Api) GetChatMenuButton(c *types.GetChatMenuButton) (*types.MenuButtons,
 // These rights will be suggested to users, but they are free to modify the list before adding the bot.
 // Returns True to success.
 func (t *Api) SetMyDefaultAdministratorRights(c *types.SetMyDefaultAdministratorRights) (bool, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+	return t.SetMyDefaultAdministratorRightsCtx(context.Background(), c)
+}
 
-	return result, err
+// SetMyDefaultAdministratorRightsCtx is the context-aware variant of SetMyDefaultAdministratorRights.
+func (t *Api) SetMyDefaultAdministratorRightsCtx(ctx context.Context, c *types.SetMyDefaultAdministratorRights) (bool, error) {
+	return Call[bool](ctx, t, c)
 }
 
 // GetMyDefaultAdministratorRights Use this method to get the current default administrator rights of the bot.
 // Returns ChatAdministratorRights on success.
-func (t *Api) GetMyDefaultAdministratorRights(c *types.GetMyDefaultAdministratorRights) (bool, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
+func (t *Api) GetMyDefaultAdministratorRights(c *types.GetMyDefaultAdministratorRights) (*types.ChatAdministratorRights, error) {
+	return t.GetMyDefaultAdministratorRightsCtx(context.Background(), c)
+}
 
-	return result, err
+// GetMyDefaultAdministratorRightsCtx is the context-aware variant of GetMyDefaultAdministratorRights.
+func (t *Api) GetMyDefaultAdministratorRightsCtx(ctx context.Context, c *types.GetMyDefaultAdministratorRights) (*types.ChatAdministratorRights, error) {
+	return Call[*types.ChatAdministratorRights](ctx, t, c)
 }
 
 // EditMessageText Use this method to edit text and game messages.
 // On success, if the edited message is not an inline message, the edited Message is returned;
 // otherwise True is returned.
 func (t *Api) EditMessageText(c *types.EditMessageText) (*types.Message, error) {
+	return t.EditMessageTextCtx(context.Background(), c)
+}
+
+// EditMessageTextCtx is the context-aware variant of EditMessageText.
+func (t *Api) EditMessageTextCtx(ctx context.Context, c *types.EditMessageText) (*types.Message, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return nil, errors.New("ChatID or Username Required")
@@ -1926,12 +1770,17 @@ func (t *Api) EditMessageText(c *types.EditMessageText) (*types.Message, error)
 		return nil, errors.New("text Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // EditInlineMessageText Use this method to edit text and game messages.
 // On success, True is returned.
 func (t *Api) EditInlineMessageText(c *types.EditMessageText) (bool, error) {
+	return t.EditInlineMessageTextCtx(context.Background(), c)
+}
+
+// EditInlineMessageTextCtx is the context-aware variant of EditInlineMessageText.
+func (t *Api) EditInlineMessageTextCtx(ctx context.Context, c *types.EditMessageText) (bool, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return false, errors.New("ChatID or Username Required")
@@ -1945,23 +1794,17 @@ func (t *Api) EditInlineMessageText(c *types.EditMessageText) (bool, error) {
 		return false, errors.New("text Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // EditMessageCaption Use this method to edit captions of messages.
 // On success, the edited Message is returned.
 func (t *Api) EditMessageCaption(c *types.EditMessageCaption) (*types.Message, error) {
+	return t.EditMessageCaptionCtx(context.Background(), c)
+}
+
+// EditMessageCaptionCtx is the context-aware variant of EditMessageCaption.
+func (t *Api) EditMessageCaptionCtx(ctx context.Context, c *types.EditMessageCaption) (*types.Message, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return nil, errors.New("ChatID or Username Required")
@@ -1971,12 +1814,17 @@ func (t *Api) EditMessageCaption(c *types.EditMessageCaption) (*types.Message, e
 		}
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // EditInlineMessageCaption Use this method to edit captions of messages.
 // On success, the edited Message is returned.
 func (t *Api) EditInlineMessageCaption(c *types.EditMessageCaption) (bool, error) {
+	return t.EditInlineMessageCaptionCtx(context.Background(), c)
+}
+
+// EditInlineMessageCaptionCtx is the context-aware variant of EditInlineMessageCaption.
+func (t *Api) EditInlineMessageCaptionCtx(ctx context.Context, c *types.EditMessageCaption) (bool, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return false, errors.New("ChatID or Username Required")
@@ -1986,18 +1834,7 @@ func (t *Api) EditInlineMessageCaption(c *types.EditMessageCaption) (bool, error
 		}
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // EditMessageMedia Use this method to edit animation, audio, document, photo, or video messages.
@@ -2007,6 +1844,11 @@ func (t *Api) EditInlineMessageCaption(c *types.EditMessageCaption) (bool, error
 // use a previously uploaded file via its file_id or specify a URL.
 // On success, the edited Message is returned.
 func (t *Api) EditMessageMedia(c *types.EditMessageMedia) (*types.Message, error) {
+	return t.EditMessageMediaCtx(context.Background(), c)
+}
+
+// EditMessageMediaCtx is the context-aware variant of EditMessageMedia.
+func (t *Api) EditMessageMediaCtx(ctx context.Context, c *types.EditMessageMedia) (*types.Message, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return nil, errors.New("ChatID or Username Required")
@@ -2019,7 +1861,7 @@ func (t *Api) EditMessageMedia(c *types.EditMessageMedia) (*types.Message, error
 		return nil, errors.New("media Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // EditInlineMessageMedia Use this method to edit animation, audio, document, photo, or video messages.
@@ -2029,6 +1871,11 @@ func (t *Api) EditMessageMedia(c *types.EditMessageMedia) (*types.Message, error
 // use a previously uploaded file via its file_id or specify a URL.
 // On success, True is returned.
 func (t *Api) EditInlineMessageMedia(c *types.EditMessageMedia) (bool, error) {
+	return t.EditInlineMessageMediaCtx(context.Background(), c)
+}
+
+// EditInlineMessageMediaCtx is the context-aware variant of EditInlineMessageMedia.
+func (t *Api) EditInlineMessageMediaCtx(ctx context.Context, c *types.EditMessageMedia) (bool, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return false, errors.New("ChatID or Username Required")
@@ -2041,23 +1888,17 @@ func (t *Api) EditInlineMessageMedia(c *types.EditMessageMedia) (bool, error) {
 		return false, errors.New("media Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // EditMessageReplyMarkup Use this method to edit only the reply markup of messages.
 // On success, the edited Message is returned.
 func (t *Api) EditMessageReplyMarkup(c *types.EditMessageReplyMarkup) (*types.Message, error) {
+	return t.EditMessageReplyMarkupCtx(context.Background(), c)
+}
+
+// EditMessageReplyMarkupCtx is the context-aware variant of EditMessageReplyMarkup.
+func (t *Api) EditMessageReplyMarkupCtx(ctx context.Context, c *types.EditMessageReplyMarkup) (*types.Message, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return nil, errors.New("ChatID or Username Required")
@@ -2067,12 +1908,17 @@ func (t *Api) EditMessageReplyMarkup(c *types.EditMessageReplyMarkup) (*types.Me
 		}
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // EditInlineMessageReplyMarkup Use this method to edit only the reply markup of messages.
 // On success, True is returned.
 func (t *Api) EditInlineMessageReplyMarkup(c *types.EditMessageReplyMarkup) (bool, error) {
+	return t.EditInlineMessageReplyMarkupCtx(context.Background(), c)
+}
+
+// EditInlineMessageReplyMarkupCtx is the context-aware variant of EditInlineMessageReplyMarkup.
+func (t *Api) EditInlineMessageReplyMarkupCtx(ctx context.Context, c *types.EditMessageReplyMarkup) (bool, error) {
 	if c.InlineMessageID == "" {
 		if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 			return false, errors.New("ChatID or Username Required")
@@ -2082,23 +1928,17 @@ func (t *Api) EditInlineMessageReplyMarkup(c *types.EditMessageReplyMarkup) (boo
 		}
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // StopPoll Use this method to stop a poll which was sent by the bot.
 // On success, the stopped Poll is returned.
 func (t *Api) StopPoll(c *types.StopPoll) (*types.Poll, error) {
+	return t.StopPollCtx(context.Background(), c)
+}
+
+// StopPollCtx is the context-aware variant of StopPoll.
+func (t *Api) StopPollCtx(ctx context.Context, c *types.StopPoll) (*types.Poll, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -2106,15 +1946,7 @@ func (t *Api) StopPoll(c *types.StopPoll) (*types.Poll, error) {
 		return nil, errors.New("MessageID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var poll types.Poll
-	err = json.Unmarshal(resp.Result, &poll)
-
-	return &poll, err
+	return Call[*types.Poll](ctx, t, c)
 }
 
 // DeleteMessage Use this method to delete a message, including service messages, with the following limitations:
@@ -2127,6 +1959,11 @@ func (t *Api) StopPoll(c *types.StopPoll) (*types.Poll, error) {
 // - If the bot has can_delete_messages permission in a supergroup or a channel, it can delete any message there.
 // Returns True to success.
 func (t *Api) DeleteMessage(c *types.DeleteMessage) (bool, error) {
+	return t.DeleteMessageCtx(context.Background(), c)
+}
+
+// DeleteMessageCtx is the context-aware variant of DeleteMessage.
+func (t *Api) DeleteMessageCtx(ctx context.Context, c *types.DeleteMessage) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -2134,24 +1971,18 @@ func (t *Api) DeleteMessage(c *types.DeleteMessage) (bool, error) {
 		return false, errors.New("MessageID Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeleteMessages Use this method to delete multiple messages simultaneously.
 // If some of the specified messages can't be found, they are skipped.
 // Returns True on success.
 func (t *Api) DeleteMessages(c *types.DeleteMessages) (bool, error) {
+	return t.DeleteMessagesCtx(context.Background(), c)
+}
+
+// DeleteMessagesCtx is the context-aware variant of DeleteMessages.
+func (t *Api) DeleteMessagesCtx(ctx context.Context, c *types.DeleteMessages) (bool, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return false, errors.New("ChatID or Username Required")
 	}
@@ -2159,23 +1990,17 @@ func (t *Api) DeleteMessages(c *types.DeleteMessages) (bool, error) {
 		return false, errors.New("MessageIds Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SendSticker Use this method to send static .WEBP, animated .TGS, or video .WEBM stickers.
 // On success, the sent Message is returned.
 func (t *Api) SendSticker(c *types.SendSticker) (*types.Message, error) {
+	return t.SendStickerCtx(context.Background(), c)
+}
+
+// SendStickerCtx is the context-aware variant of SendSticker.
+func (t *Api) SendStickerCtx(ctx context.Context, c *types.SendSticker) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -2183,48 +2008,47 @@ func (t *Api) SendSticker(c *types.SendSticker) (*types.Message, error) {
 		return nil, errors.New("MessageID Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // GetStickerSet Use this method to get a sticker set. On success, a StickerSet object is returned.
 func (t *Api) GetStickerSet(c *types.GetStickerSet) (*types.StickerSet, error) {
+	return t.GetStickerSetCtx(context.Background(), c)
+}
+
+// GetStickerSetCtx is the context-aware variant of GetStickerSet.
+func (t *Api) GetStickerSetCtx(ctx context.Context, c *types.GetStickerSet) (*types.StickerSet, error) {
 	if c.Name == "" {
 		return nil, errors.New("name Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var stickerSet types.StickerSet
-	err = json.Unmarshal(resp.Result, &stickerSet)
-
-	return &stickerSet, err
+	return Call[*types.StickerSet](ctx, t, c)
 }
 
 // GetCustomEmojiStickers Use this method to get information about custom emoji stickers by their identifiers.
 // Returns an Array of Sticker objects.
 func (t *Api) GetCustomEmojiStickers(c *types.GetCustomEmojiStickers) ([]types.Sticker, error) {
+	return t.GetCustomEmojiStickersCtx(context.Background(), c)
+}
+
+// GetCustomEmojiStickersCtx is the context-aware variant of GetCustomEmojiStickers.
+func (t *Api) GetCustomEmojiStickersCtx(ctx context.Context, c *types.GetCustomEmojiStickers) ([]types.Sticker, error) {
 	if len(c.CustomEmojiIds) < 1 {
 		return nil, errors.New("customEmojiIds Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var stickerSet []types.Sticker
-	err = json.Unmarshal(resp.Result, &stickerSet)
-
-	return stickerSet, err
+	return Call[[]types.Sticker](ctx, t, c)
 }
 
 // UploadStickerFile Use this method to upload a file with a sticker for later use in the createNewStickerSet
 // and addStickerToSet methods (the file can be used multiple times).
 // Returns the uploaded File on success.
 func (t *Api) UploadStickerFile(c *types.UploadStickerFile) (*types.File, error) {
+	return t.UploadStickerFileCtx(context.Background(), c)
+}
+
+// UploadStickerFileCtx is the context-aware variant of UploadStickerFile.
+func (t *Api) UploadStickerFileCtx(ctx context.Context, c *types.UploadStickerFile) (*types.File, error) {
 	if c.UserID == 0 {
 		return nil, errors.New("UserID Required")
 	}
@@ -2235,21 +2059,18 @@ func (t *Api) UploadStickerFile(c *types.UploadStickerFile) (*types.File, error)
 		return nil, errors.New("stickerFormat Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var file types.File
-	err = json.Unmarshal(resp.Result, &file)
-
-	return &file, err
+	return Call[*types.File](ctx, t, c)
 }
 
 // CreateNewStickerSet Use this method to create a new sticker set owned by a user.
 // The bot will be able to edit the sticker set thus created.
 // Returns True to success.
 func (t *Api) CreateNewStickerSet(c *types.CreateNewStickerSet) (bool, error) {
+	return t.CreateNewStickerSetCtx(context.Background(), c)
+}
+
+// CreateNewStickerSetCtx is the context-aware variant of CreateNewStickerSet.
+func (t *Api) CreateNewStickerSetCtx(ctx context.Context, c *types.CreateNewStickerSet) (bool, error) {
 	if c.UserID == 0 {
 		return false, errors.New("UserID Required")
 	}
@@ -2263,18 +2084,7 @@ func (t *Api) CreateNewStickerSet(c *types.CreateNewStickerSet) (bool, error) {
 		return false, errors.New("stickers Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // AddStickerToSet Use this method to add a new sticker to a set created by the bot.
@@ -2284,6 +2094,11 @@ func (t *Api) CreateNewStickerSet(c *types.CreateNewStickerSet) (bool, error) {
 // Static sticker sets can have up to 120 stickers.
 // Returns True to success.
 func (t *Api) AddStickerToSet(c *types.AddStickerToSet) (bool, error) {
+	return t.AddStickerToSetCtx(context.Background(), c)
+}
+
+// AddStickerToSetCtx is the context-aware variant of AddStickerToSet.
+func (t *Api) AddStickerToSetCtx(ctx context.Context, c *types.AddStickerToSet) (bool, error) {
 	if c.UserID == 0 {
 		return false, errors.New("UserID Required")
 	}
@@ -2294,23 +2109,17 @@ func (t *Api) AddStickerToSet(c *types.AddStickerToSet) (bool, error) {
 		return false, errors.New("sticker Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetStickerPositionInSet Use this method to move a sticker in a set created by the bot to a specific position.
 // Returns True to success.
 func (t *Api) SetStickerPositionInSet(c *types.SetStickerPositionInSet) (bool, error) {
+	return t.SetStickerPositionInSetCtx(context.Background(), c)
+}
+
+// SetStickerPositionInSetCtx is the context-aware variant of SetStickerPositionInSet.
+func (t *Api) SetStickerPositionInSetCtx(ctx context.Context, c *types.SetStickerPositionInSet) (bool, error) {
 	if c.Sticker == "" {
 		return false, errors.New("sticker Required")
 	}
@@ -2318,44 +2127,32 @@ func (t *Api) SetStickerPositionInSet(c *types.SetStickerPositionInSet) (bool, e
 		return false, errors.New("position Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeleteStickerFromSet Use this method to delete a sticker from a set created by the bot.
 // Returns True to success.
 func (t *Api) DeleteStickerFromSet(c *types.DeleteStickerFromSet) (bool, error) {
+	return t.DeleteStickerFromSetCtx(context.Background(), c)
+}
+
+// DeleteStickerFromSetCtx is the context-aware variant of DeleteStickerFromSet.
+func (t *Api) DeleteStickerFromSetCtx(ctx context.Context, c *types.DeleteStickerFromSet) (bool, error) {
 	if c.Sticker == "" {
 		return false, errors.New("sticker Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // ReplaceStickerInSet Use this method to replace an existing sticker in a sticker set with a new one. The method is equivalent to calling deleteStickerFromSet, then addStickerToSet, then setStickerPositionInSet.
 // Returns True on success
 func (t *Api) ReplaceStickerInSet(c *types.ReplaceStickerInSet) (bool, error) {
+	return t.ReplaceStickerInSetCtx(context.Background(), c)
+}
+
+// ReplaceStickerInSetCtx is the context-aware variant of ReplaceStickerInSet.
+func (t *Api) ReplaceStickerInSetCtx(ctx context.Context, c *types.ReplaceStickerInSet) (bool, error) {
 	if c.UserID == 0 {
 		return false, errors.New("UserID Required")
 	}
@@ -2366,24 +2163,18 @@ func (t *Api) ReplaceStickerInSet(c *types.ReplaceStickerInSet) (bool, error) {
 		return false, errors.New("OldSticker Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetStickerEmojiList Use this method to change the list of emoji assigned to a regular or custom emoji sticker.
 // The sticker must belong to a sticker set created by the bot.
 // Returns True to success.
 func (t *Api) SetStickerEmojiList(c *types.SetStickerEmojiList) (bool, error) {
+	return t.SetStickerEmojiListCtx(context.Background(), c)
+}
+
+// SetStickerEmojiListCtx is the context-aware variant of SetStickerEmojiList.
+func (t *Api) SetStickerEmojiListCtx(ctx context.Context, c *types.SetStickerEmojiList) (bool, error) {
 	if c.Sticker == "" {
 		return false, errors.New("sticker Required")
 	}
@@ -2391,67 +2182,49 @@ func (t *Api) SetStickerEmojiList(c *types.SetStickerEmojiList) (bool, error) {
 		return false, errors.New("emojiList Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetStickerKeywords Use this method to change search keywords assigned to a regular or custom emoji sticker.
 // The sticker must belong to a sticker set created by the bot.
 // Returns True to success.
 func (t *Api) SetStickerKeywords(c *types.SetStickerKeywords) (bool, error) {
+	return t.SetStickerKeywordsCtx(context.Background(), c)
+}
+
+// SetStickerKeywordsCtx is the context-aware variant of SetStickerKeywords.
+func (t *Api) SetStickerKeywordsCtx(ctx context.Context, c *types.SetStickerKeywords) (bool, error) {
 	if c.Sticker == "" {
 		return false, errors.New("sticker Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetStickerMaskPosition Use this method to change the mask position of a mask sticker.
 // The sticker must belong to a sticker set that was created by the bot.
 // Returns True to success.
 func (t *Api) SetStickerMaskPosition(c *types.SetStickerMaskPosition) (bool, error) {
+	return t.SetStickerMaskPositionCtx(context.Background(), c)
+}
+
+// SetStickerMaskPositionCtx is the context-aware variant of SetStickerMaskPosition.
+func (t *Api) SetStickerMaskPositionCtx(ctx context.Context, c *types.SetStickerMaskPosition) (bool, error) {
 	if c.Sticker == "" {
 		return false, errors.New("sticker Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetStickerSetTitle Use this method to set the title of a created sticker set.
 // Returns True to success.
 func (t *Api) SetStickerSetTitle(c *types.SetStickerSetTitle) (bool, error) {
+	return t.SetStickerSetTitleCtx(context.Background(), c)
+}
+
+// SetStickerSetTitleCtx is the context-aware variant of SetStickerSetTitle.
+func (t *Api) SetStickerSetTitleCtx(ctx context.Context, c *types.SetStickerSetTitle) (bool, error) {
 	if c.Name == "" {
 		return false, errors.New("name Required")
 	}
@@ -2459,24 +2232,18 @@ func (t *Api) SetStickerSetTitle(c *types.SetStickerSetTitle) (bool, error) {
 		return false, errors.New("title Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetStickerSetThumbnail Use this method to set the thumbnail of a regular or mask sticker set.
 // The format of the thumbnail file must match the format of the stickers in the set.
 // Returns True to success.
 func (t *Api) SetStickerSetThumbnail(c *types.SetStickerSetThumbnail) (bool, error) {
+	return t.SetStickerSetThumbnailCtx(context.Background(), c)
+}
+
+// SetStickerSetThumbnailCtx is the context-aware variant of SetStickerSetThumbnail.
+func (t *Api) SetStickerSetThumbnailCtx(ctx context.Context, c *types.SetStickerSetThumbnail) (bool, error) {
 	if c.Name == "" {
 		return false, errors.New("name Required")
 	}
@@ -2487,65 +2254,47 @@ func (t *Api) SetStickerSetThumbnail(c *types.SetStickerSetThumbnail) (bool, err
 		return false, errors.New("format Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetCustomEmojiStickerSetThumbnail Use this method to set the thumbnail of a custom emoji sticker set.
 // Returns True to success.
 func (t *Api) SetCustomEmojiStickerSetThumbnail(c *types.SetCustomEmojiStickerSetThumbnail) (bool, error) {
+	return t.SetCustomEmojiStickerSetThumbnailCtx(context.Background(), c)
+}
+
+// SetCustomEmojiStickerSetThumbnailCtx is the context-aware variant of SetCustomEmojiStickerSetThumbnail.
+func (t *Api) SetCustomEmojiStickerSetThumbnailCtx(ctx context.Context, c *types.SetCustomEmojiStickerSetThumbnail) (bool, error) {
 	if c.Name == "" {
 		return false, errors.New("name Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // DeleteStickerSet Use this method to delete a sticker set that was created by the bot. Returns True to success.
 func (t *Api) DeleteStickerSet(c *types.DeleteStickerSet) (bool, error) {
+	return t.DeleteStickerSetCtx(context.Background(), c)
+}
+
+// DeleteStickerSetCtx is the context-aware variant of DeleteStickerSet.
+func (t *Api) DeleteStickerSetCtx(ctx context.Context, c *types.DeleteStickerSet) (bool, error) {
 	if c.Name == "" {
 		return false, errors.New("name Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // AnswerInlineQuery Use this method to send answers to an inline query.
 // On success, True is returned.
 // No more than 50 results per query are allowed.
 func (t *Api) AnswerInlineQuery(c *types.AnswerInlineQuery) (bool, error) {
+	return t.AnswerInlineQueryCtx(context.Background(), c)
+}
+
+// AnswerInlineQueryCtx is the context-aware variant of AnswerInlineQuery.
+func (t *Api) AnswerInlineQueryCtx(ctx context.Context, c *types.AnswerInlineQuery) (bool, error) {
 	if c.InlineQueryID == "" {
 		return false, errors.New("InlineQueryID Required")
 	}
@@ -2553,24 +2302,18 @@ func (t *Api) AnswerInlineQuery(c *types.AnswerInlineQuery) (bool, error) {
 		return false, errors.New("results Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // AnswerWebAppQuery Use this method to set the result of an interaction with a Web App
 // and send a corresponding message on behalf of the user to the chat from which the query originated.
 // On success, a SentWebAppMessage object is returned.
 func (t *Api) AnswerWebAppQuery(c *types.AnswerWebAppQuery) (bool, error) {
+	return t.AnswerWebAppQueryCtx(context.Background(), c)
+}
+
+// AnswerWebAppQueryCtx is the context-aware variant of AnswerWebAppQuery.
+func (t *Api) AnswerWebAppQueryCtx(ctx context.Context, c *types.AnswerWebAppQuery) (bool, error) {
 	if c.WebAppQueryID == "" {
 		return false, errors.New("WebAppQueryID Required")
 	}
@@ -2578,22 +2321,16 @@ func (t *Api) AnswerWebAppQuery(c *types.AnswerWebAppQuery) (bool, error) {
 		return false, errors.New("result Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SendInvoice Use this method to send invoices. On success, the sent Message is returned.
 func (t *Api) SendInvoice(c *types.SendInvoice) (*types.Message, error) {
+	return t.SendInvoiceCtx(context.Background(), c)
+}
+
+// SendInvoiceCtx is the context-aware variant of SendInvoice.
+func (t *Api) SendInvoiceCtx(ctx context.Context, c *types.SendInvoice) (*types.Message, error) {
 	if c.ChatID == 0 && c.ChatIDStr == "" && c.Username == "" {
 		return nil, errors.New("ChatID or Username Required")
 	}
@@ -2613,11 +2350,16 @@ func (t *Api) SendInvoice(c *types.SendInvoice) (*types.Message, error) {
 		return nil, errors.New("prices Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // CreateInvoiceLink Use this method to create a link for an invoice. Returns the created invoice link as String on success.
 func (t *Api) CreateInvoiceLink(c *types.CreateInvoiceLink) (string, error) {
+	return t.CreateInvoiceLinkCtx(context.Background(), c)
+}
+
+// CreateInvoiceLinkCtx is the context-aware variant of CreateInvoiceLink.
+func (t *Api) CreateInvoiceLinkCtx(ctx context.Context, c *types.CreateInvoiceLink) (string, error) {
 	if c.Title == "" {
 		return "", errors.New("title Required")
 	}
@@ -2634,52 +2376,33 @@ func (t *Api) CreateInvoiceLink(c *types.CreateInvoiceLink) (string, error) {
 		return "", errors.New("prices Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return "", err
-	}
-
-	var createInvoiceLink string
-
-	err = json.Unmarshal(resp.Result, &createInvoiceLink)
-	if err != nil {
-		return "", err
-	}
-
-	return createInvoiceLink, nil
+	return Call[string](ctx, t, c)
 }
 
 // AnswerShippingQuery If you sent an invoice requesting a shipping address and the parameter is_flexible was specified,
 // the Bot API will send an Update with a shipping_query field to the bot.
 // Use this method to reply to shipping queries.
 // On success, True is returned.
-func (t *Api) AnswerShippingQuery(c *types.AnswerShippingQuery) (string, error) {
+func (t *Api) AnswerShippingQuery(c *types.AnswerShippingQuery) (bool, error) {
+	return t.AnswerShippingQueryCtx(context.Background(), c)
+}
+
+// AnswerShippingQueryCtx is the context-aware variant of AnswerShippingQuery.
+func (t *Api) AnswerShippingQueryCtx(ctx context.Context, c *types.AnswerShippingQuery) (bool, error) {
 	if c.ShippingQueryID == "" {
-		return "", errors.New("ShippingQueryID Required")
+		return false, errors.New("ShippingQueryID Required")
 	}
 	if c.OK {
 		if c.ShippingOptions == nil {
-			return "", errors.New("ShippingOptions Required")
+			return false, errors.New("ShippingOptions Required")
 		}
 	} else {
 		if c.ErrorMessage == "" {
-			return "", errors.New("ErrorMessage Required")
+			return false, errors.New("ErrorMessage Required")
 		}
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return "", err
-	}
-
-	var createInvoiceLink string
-
-	err = json.Unmarshal(resp.Result, &createInvoiceLink)
-	if err != nil {
-		return "", err
-	}
-
-	return createInvoiceLink, nil
+	return Call[bool](ctx, t, c)
 }
 
 // AnswerPreCheckoutQuery If you sent an invoice requesting a shipping address,
@@ -2688,6 +2411,11 @@ func (t *Api) AnswerShippingQuery(c *types.AnswerShippingQuery) (string, error)
 // Use this method to reply to shipping queries.
 // On success, True is returned.
 func (t *Api) AnswerPreCheckoutQuery(c *types.AnswerPreCheckoutQuery) (bool, error) {
+	return t.AnswerPreCheckoutQueryCtx(context.Background(), c)
+}
+
+// AnswerPreCheckoutQueryCtx is the context-aware variant of AnswerPreCheckoutQuery.
+func (t *Api) AnswerPreCheckoutQueryCtx(ctx context.Context, c *types.AnswerPreCheckoutQuery) (bool, error) {
 	if c.PreCheckoutQueryID == "" {
 		return false, errors.New("ShippingQueryID Required")
 	}
@@ -2697,37 +2425,28 @@ func (t *Api) AnswerPreCheckoutQuery(c *types.AnswerPreCheckoutQuery) (bool, err
 		}
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // GetStarTransactions Returns the bot's Telegram Star transactions in chronological order.
 // On success, returns a StarTransactions object.
 func (t *Api) GetStarTransactions(c *types.GetStarTransactions) (*types.StarTransactions, error) {
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var starTransactions types.StarTransactions
-	err = json.Unmarshal(resp.Result, &starTransactions)
+	return t.GetStarTransactionsCtx(context.Background(), c)
+}
 
-	return &starTransactions, err
+// GetStarTransactionsCtx is the context-aware variant of GetStarTransactions.
+func (t *Api) GetStarTransactionsCtx(ctx context.Context, c *types.GetStarTransactions) (*types.StarTransactions, error) {
+	return Call[*types.StarTransactions](ctx, t, c)
 }
 
 // RefundStarPayment Refunds a successful payment in Telegram Stars.
 // Returns True on success.
 func (t *Api) RefundStarPayment(c *types.RefundStarPayment) (bool, error) {
+	return t.RefundStarPaymentCtx(context.Background(), c)
+}
+
+// RefundStarPaymentCtx is the context-aware variant of RefundStarPayment.
+func (t *Api) RefundStarPaymentCtx(ctx context.Context, c *types.RefundStarPayment) (bool, error) {
 	if c.UserId == "" {
 		return false, errors.New("UserId Required")
 	}
@@ -2735,18 +2454,7 @@ func (t *Api) RefundStarPayment(c *types.RefundStarPayment) (bool, error) {
 		return false, errors.New("TelegramPaymentChargeId Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SetPassportDataErrors Informs a user that some of the Telegram Passport elements they provided contains errors.
@@ -2758,6 +2466,11 @@ func (t *Api) RefundStarPayment(c *types.RefundStarPayment) (bool, error) {
 // a scan shows evidence of tampering,
 // etc. Supply some details in the error message to make sure the user knows how to correct the issues.
 func (t *Api) SetPassportDataErrors(c *types.SetPassportDataErrors) (bool, error) {
+	return t.SetPassportDataErrorsCtx(context.Background(), c)
+}
+
+// SetPassportDataErrorsCtx is the context-aware variant of SetPassportDataErrors.
+func (t *Api) SetPassportDataErrorsCtx(ctx context.Context, c *types.SetPassportDataErrors) (bool, error) {
 	if c.UserID == 0 {
 		return false, errors.New("UserID Required")
 	}
@@ -2765,22 +2478,16 @@ func (t *Api) SetPassportDataErrors(c *types.SetPassportDataErrors) (bool, error
 		return false, errors.New("errors Required")
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return false, err
-	}
-
-	var result bool
-	err = json.Unmarshal(resp.Result, &result)
-	if err != nil {
-		return false, err
-	}
-
-	return result, err
+	return Call[bool](ctx, t, c)
 }
 
 // SendGame Use this method to send a game. On success, the sent Message is returned.
 func (t *Api) SendGame(c *types.SendGame) (*types.Message, error) {
+	return t.SendGameCtx(context.Background(), c)
+}
+
+// SendGameCtx is the context-aware variant of SendGame.
+func (t *Api) SendGameCtx(ctx context.Context, c *types.SendGame) (*types.Message, error) {
 	if c.ChatID == 0 {
 		return nil, errors.New("ChatID Required")
 	}
@@ -2788,13 +2495,18 @@ func (t *Api) SendGame(c *types.SendGame) (*types.Message, error) {
 		return nil, errors.New("GameShortName Required")
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // SetGameScore Use this method to set the score of the specified user in a game message.
 // On success, if the message is not an inline message, the Message is returned, otherwise True is returned.
 // Returns an error, if the new score is not greater than the user's current score in the chat and force is False.
 func (t *Api) SetGameScore(c *types.SetGameScore) (*types.Message, error) {
+	return t.SetGameScoreCtx(context.Background(), c)
+}
+
+// SetGameScoreCtx is the context-aware variant of SetGameScore.
+func (t *Api) SetGameScoreCtx(ctx context.Context, c *types.SetGameScore) (*types.Message, error) {
 	if c.UserID == 0 {
 		return nil, errors.New("UserID Required")
 	}
@@ -2810,7 +2522,7 @@ func (t *Api) SetGameScore(c *types.SetGameScore) (*types.Message, error) {
 		}
 	}
 
-	return t.Send(c)
+	return t.SendCtx(ctx, c)
 }
 
 // GetGameHighScores Use this method to get data for high-score tables.
@@ -2820,6 +2532,11 @@ func (t *Api) SetGameScore(c *types.SetGameScore) (*types.Message, error) {
 // Will also return the top three users if the user and their neighbors are not among them.
 // Please note that this behavior is subject to change.
 func (t *Api) GetGameHighScores(c *types.GetGameHighScores) ([]types.GameHighScore, error) {
+	return t.GetGameHighScoresCtx(context.Background(), c)
+}
+
+// GetGameHighScoresCtx is the context-aware variant of GetGameHighScores.
+func (t *Api) GetGameHighScoresCtx(ctx context.Context, c *types.GetGameHighScores) ([]types.GameHighScore, error) {
 	if c.UserID == 0 {
 		return nil, errors.New("UserID Required")
 	}
@@ -2832,17 +2549,5 @@ func (t *Api) GetGameHighScores(c *types.GetGameHighScores) ([]types.GameHighSco
 		}
 	}
 
-	resp, err := t.Request(c)
-	if err != nil {
-		return nil, err
-	}
-
-	var gameHighScore []types.GameHighScore
-
-	err = json.Unmarshal(resp.Result, &gameHighScore)
-	if err != nil {
-		return nil, err
-	}
-
-	return gameHighScore, nil
+	return Call[[]types.GameHighScore](ctx, t, c)
 }
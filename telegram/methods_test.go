@@ -0,0 +1,73 @@
+package telegram
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestGetUpdatesChanAcks(t *testing.T) {
+	store := NewFileOffsetStore(filepath.Join(t.TempDir(), "offset"))
+	api := &Api{Bot: &types.BotApi{OffsetStore: store}}
+	api.Use(fakeUpdates(types.Update{UpdateID: 1}, types.Update{UpdateID: 2}, types.Update{UpdateID: 3}))
+
+	updates := api.GetUpdatesChan(api.NewGetUpdates())
+	for want := 1; want <= 3; want++ {
+		select {
+		case update := <-updates:
+			if update.UpdateID != want {
+				t.Fatalf("update = %d, want %d", update.UpdateID, want)
+			}
+			//a late repeated ack of the previous update must not swallow the ack of this one
+			api.AckUpdate(update.UpdateID - 1)
+			api.AckUpdate(update.UpdateID)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("update %d not delivered", want)
+		}
+	}
+
+	api.StopReceivingUpdates()
+	for range updates {
+	}
+
+	offset, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset != 4 {
+		t.Errorf("offset = %d, want 4", offset)
+	}
+}
+
+func TestGetUpdatesChanAckOtherID(t *testing.T) {
+	store := NewFileOffsetStore(filepath.Join(t.TempDir(), "offset"))
+	api := &Api{Bot: &types.BotApi{OffsetStore: store}}
+	api.Use(fakeUpdates(types.Update{UpdateID: 1}, types.Update{UpdateID: 2}))
+	defer api.StopReceivingUpdates()
+
+	updates := api.GetUpdatesChan(api.NewGetUpdates())
+	if update := <-updates; update.UpdateID != 1 {
+		t.Fatalf("update = %d, want 1", update.UpdateID)
+	}
+
+	//update ids are not always increasing, an ack of a higher id must not release update 1
+	api.AckUpdate(2)
+	select {
+	case update := <-updates:
+		t.Fatalf("update %d delivered before update 1 was acknowledged", update.UpdateID)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	api.AckUpdate(1)
+	select {
+	case update := <-updates:
+		if update.UpdateID != 2 {
+			t.Fatalf("update = %d, want 2", update.UpdateID)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("update 2 not delivered")
+	}
+}
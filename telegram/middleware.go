@@ -0,0 +1,105 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+)
+
+// Middleware wraps a HandlerFunc. It may act before and after calling next, or skip next to stop the update.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// PanicError is returned by a handler wrapped in Recover when it panicked.
+type PanicError struct {
+	Value any    // The value passed to panic
+	Stack []byte // Stack trace of the panicking goroutine
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in update handler: %v", e.Value)
+}
+
+// Use registers middleware around every handler of the dispatcher, including the ones of groups and OnOther.
+// Middleware runs in the order it was registered, the first one being the outermost, and before the middleware of a group.
+// Use must be called before the first update is dispatched.
+func (d *Dispatcher) Use(middleware ...Middleware) {
+	d.middleware = append(d.middleware, middleware...)
+}
+
+// Group returns a Router whose handlers are wrapped in the middleware of r followed by middleware.
+func (r *Router) Group(middleware ...Middleware) *Router {
+	group := make([]Middleware, 0, len(r.middleware)+len(middleware))
+	group = append(group, r.middleware...)
+	group = append(group, middleware...)
+
+	return &Router{dispatcher: r.dispatcher, middleware: group}
+}
+
+// Recover turns a panic of the handler into a *PanicError and logs it with the stack trace.
+// Poller and WebhookHandler skip an update that failed with a *PanicError instead of delivering it again.
+func Recover(next HandlerFunc) HandlerFunc {
+	return func(c *UpdateContext) (err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				panicErr := &PanicError{Value: v, Stack: debug.Stack()}
+				c.Api.logger().Error("recovered panic in update handler",
+					slog.String("error", panicErr.Error()),
+					slog.Int("update_id", c.Update.UpdateID),
+					slog.String("stack", string(panicErr.Stack)),
+				)
+				err = panicErr
+			}
+		}()
+
+		return next(c)
+	}
+}
+
+// Logger logs every handled update with its kind and the time the handler took, failed updates are logged as errors.
+func Logger(next HandlerFunc) HandlerFunc {
+	return func(c *UpdateContext) error {
+		start := time.Now()
+		err := next(c)
+
+		attrs := []slog.Attr{
+			slog.Int("update_id", c.Update.UpdateID),
+			slog.String("kind", updateKind(c.Update)),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+			c.Api.logger().LogAttrs(c, slog.LevelError, "failed to handle update", attrs...)
+		} else {
+			c.Api.logger().LogAttrs(c, slog.LevelInfo, "handled update", attrs...)
+		}
+
+		return err
+	}
+}
+
+// Timeout cancels the context of the handler after d. The handler has to pass the UpdateContext
+// to its calls, or check it, to be stopped in time.
+func Timeout(d time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(c *UpdateContext) error {
+			ctx, cancel := context.WithTimeout(c.Context, d)
+			defer cancel()
+
+			timed := *c
+			timed.Context = ctx
+
+			return next(&timed)
+		}
+	}
+}
+
+// wrap applies middleware to h, the first one being the outermost.
+func wrap(h HandlerFunc, middleware []Middleware) HandlerFunc {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+
+	return h
+}
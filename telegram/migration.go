@@ -0,0 +1,46 @@
+package telegram
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// TrackMigration reports group to supergroup migration service messages of an update to OnChatMigrated.
+// Updates received by GetUpdatesChan are tracked automatically, updates received via webhook should be passed here.
+// Telegram sends a service message to both chats, so the callback may be called twice for the same migration.
+func (t *Api) TrackMigration(update *types.Update) {
+	m := update.Message
+	if m == nil {
+		return
+	}
+
+	switch {
+	case m.MigrateToChatID != 0:
+		t.chatMigrated(m.Chat.ID, m.MigrateToChatID)
+	case m.MigrateFromChatID != 0:
+		t.chatMigrated(m.MigrateFromChatID, m.Chat.ID)
+	}
+}
+
+// migrationTarget returns the new supergroup id if err reports that the target chat was migrated.
+func (t *Api) migrationTarget(params types.Params, err error) (int64, bool) {
+	toChatID, ok := types.MigrateToChatID(err)
+	if !ok {
+		return 0, false
+	}
+
+	fromChatID, _ := strconv.ParseInt(params["chat_id"], 10, 64)
+	t.chatMigrated(fromChatID, toChatID)
+
+	return toChatID, true
+}
+
+func (t *Api) chatMigrated(fromChatID, toChatID int64) {
+	t.logger().Info("chat migrated", slog.Int64("from_chat_id", fromChatID), slog.Int64("to_chat_id", toChatID))
+
+	if t.Bot.OnChatMigrated != nil {
+		t.Bot.OnChatMigrated(fromChatID, toChatID)
+	}
+}
@@ -0,0 +1,83 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestFollowMigrationUpload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.txt")
+	if err := os.WriteFile(path, []byte("content"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		file  func(tg *Api) types.RequestFileData
+		spool bool
+		retry bool
+		want  []string
+	}{
+		{"bytes", func(tg *Api) types.RequestFileData { return tg.FileBytes("a.txt", []byte("content")) }, false, false, []string{"1:content", "2:content"}},
+		{"path", func(tg *Api) types.RequestFileData { return tg.FilePath(path) }, false, false, []string{"1:content", "2:content"}},
+		{"seeker", func(tg *Api) types.RequestFileData { return tg.FileReader("a.txt", bytes.NewReader([]byte("content"))) }, false, false, []string{"1:content", "2:content"}},
+		{"seeker with retry", func(tg *Api) types.RequestFileData { return tg.FileReader("a.txt", bytes.NewReader([]byte("content"))) }, false, true, []string{"1:content", "2:content"}},
+		{"open file", func(tg *Api) types.RequestFileData {
+			file, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return tg.FileReader("a.txt", file)
+		}, false, false, []string{"1:content", "2:content"}},
+		{"plain reader", func(tg *Api) types.RequestFileData {
+			return tg.FileReader("a.txt", io.MultiReader(strings.NewReader("content")))
+		}, false, false, []string{"1:content"}},
+		{"spooled plain reader", func(tg *Api) types.RequestFileData {
+			return tg.FileReader("a.txt", io.MultiReader(strings.NewReader("content")))
+		}, true, false, []string{"1:content", "2:content"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &Api{Bot: &types.BotApi{FollowMigration: true, SpoolUploads: tt.spool}}
+			if tt.retry {
+				api.Bot.Retry = &types.RetryPolicy{}
+			}
+			var sent []string
+			api.Use(InterceptorFunc(func(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile, next Invoker) (*types.APIResponse, error) {
+				_, reader, err := files[0].Data.UploadData()
+				if err != nil {
+					return nil, err
+				}
+				data, err := io.ReadAll(reader)
+				if err != nil {
+					return nil, err
+				}
+				sent = append(sent, params["chat_id"]+":"+string(data))
+
+				if params["chat_id"] == "1" {
+					return nil, &types.Error{Code: 400, Message: "Bad Request: group chat was upgraded to a supergroup chat", ResponseParameters: types.ResponseParameters{MigrateToChatID: 2}}
+				}
+				return &types.APIResponse{Ok: true, Result: json.RawMessage(`{"message_id":1,"date":0,"chat":{"id":2,"type":"supergroup"}}`)}, nil
+			}))
+
+			doc := api.NewSendDocument()
+			doc.ChatID = 1
+			doc.Document = tt.file(api)
+			_, _ = api.Request(doc)
+
+			if !reflect.DeepEqual(sent, tt.want) {
+				t.Errorf("sent = %q, want %q", sent, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FileOffsetStore is an OffsetStore keeping the offset in a file.
+type FileOffsetStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileOffsetStore returns an OffsetStore writing the offset to path.
+func NewFileOffsetStore(path string) *FileOffsetStore {
+	return &FileOffsetStore{path: path}
+}
+
+// Load returns the stored offset, or 0 if the file does not exist yet.
+func (s *FileOffsetStore) Load(_ context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+// Save replaces the stored offset. The file is written next to the old one and renamed, so a crash never leaves it half written.
+func (s *FileOffsetStore) Save(_ context.Context, offset int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func(name string) {
+		_ = os.Remove(name)
+	}(file.Name())
+
+	if _, err = file.WriteString(strconv.Itoa(offset) + "\n"); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), s.path)
+}
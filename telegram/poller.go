@@ -0,0 +1,230 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+const (
+	confirmTimeout     = 5 * time.Second
+	defaultMaxAttempts = 5
+)
+
+// UpdateHandler handles an update received by a Poller.
+// Returning an error leaves the update unacknowledged, it is fetched and handled again after a backoff,
+// until Poller.MaxAttempts is reached or the error is a *PanicError.
+type UpdateHandler func(ctx context.Context, update types.Update) error
+
+// Poller receives updates through long polling and hands them to its handler one at a time, in order.
+// The offset only moves past an update once the handler returned, so with a Store every update is handled at least once across restarts.
+// An update whose handler keeps failing is skipped after MaxAttempts, so it does not hold back the updates after it.
+type Poller struct {
+	MinBackoff  time.Duration                        // Optional. First delay after a failed poll or handler. Defaults to 1s
+	MaxBackoff  time.Duration                        // Optional. Upper bound for the delay between failed attempts. Defaults to 1 minute
+	MaxAttempts int                                  // Optional. Number of times an update is handled before it is skipped. Defaults to 5, a *PanicError skips it right away
+	OnFailure   func(update types.Update, err error) // Optional. Called with the last error of an update that is skipped
+	Store       types.OffsetStore                    // Optional. Persists the offset of handled updates. Defaults to Bot.OffsetStore
+
+	api      *Api
+	config   types.GetUpdates
+	handler  UpdateHandler
+	start    sync.Once
+	done     chan struct{}
+	failedID int
+	attempts int
+}
+
+// NewPoller returns a Poller fetching updates with the options of c and passing them to handler.
+func (t *Api) NewPoller(c *types.GetUpdates, handler UpdateHandler) *Poller {
+	config := *c
+	if config.Limit < 1 || config.Limit > 100 {
+		config.Limit = 100
+	}
+
+	return &Poller{
+		Store:   t.Bot.OffsetStore,
+		api:     t,
+		config:  config,
+		handler: handler,
+		done:    make(chan struct{}),
+	}
+}
+
+// Start polls for updates in the background until ctx is cancelled.
+// Cancelling ctx aborts the pending long-poll request, the update being handled is finished
+// and the offset of the handled updates is confirmed to Telegram before the poller stops.
+func (p *Poller) Start(ctx context.Context) {
+	p.start.Do(func() {
+		go p.run(ctx)
+	})
+}
+
+// Wait blocks until the poller stopped and every dispatched update has been handled.
+func (p *Poller) Wait() {
+	<-p.done
+}
+
+func (p *Poller) run(ctx context.Context) {
+	defer close(p.done)
+
+	if p.Store != nil {
+		offset, err := p.Store.Load(ctx)
+		if err != nil {
+			p.api.logger().Error("failed to load update offset", slog.String("error", err.Error()))
+		}
+		if offset > p.config.Offset {
+			p.config.Offset = offset
+		}
+	}
+
+	confirmed := p.config.Offset
+	failures := 0
+	for ctx.Err() == nil {
+		msg := "failed to get updates"
+		updates, err := p.api.GetUpdatesCtx(ctx, &p.config)
+		if err == nil {
+			confirmed = p.config.Offset
+			msg = "failed to handle update"
+			err = p.dispatch(ctx, updates)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		if err != nil {
+			failures++
+			delay := p.backoff(failures, err)
+			p.api.logger().Error(msg,
+				slog.String("error", err.Error()),
+				slog.Duration("retry_in", delay),
+			)
+			sleep(ctx, delay)
+			continue
+		}
+		failures = 0
+	}
+
+	if p.config.Offset != confirmed {
+		p.confirm(ctx)
+	}
+}
+
+// dispatch hands updates to the handler and advances the offset past every acknowledged update.
+func (p *Poller) dispatch(ctx context.Context, updates []types.Update) error {
+	for _, update := range updates {
+		if update.UpdateID < p.config.Offset {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if p.api.duplicate(ctx, update) {
+			p.commit(ctx, update.UpdateID+1)
+			continue
+		}
+
+		p.api.TrackMigration(&update)
+		if err := p.handler(ctx, update); err != nil {
+			if ctx.Err() != nil || !p.giveUp(update, err) {
+				p.api.forget(ctx, update)
+				return err
+			}
+		}
+		p.commit(ctx, update.UpdateID+1)
+	}
+
+	return nil
+}
+
+// giveUp counts a failed attempt of update and reports whether it has to be skipped.
+func (p *Poller) giveUp(update types.Update, err error) bool {
+	if update.UpdateID != p.failedID {
+		p.failedID = update.UpdateID
+		p.attempts = 0
+	}
+	p.attempts++
+
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	var panicErr *PanicError
+	if p.attempts < maxAttempts && !errors.As(err, &panicErr) {
+		return false
+	}
+
+	p.api.logger().Error("skipped update after failed attempts",
+		slog.String("error", err.Error()),
+		slog.Int("update_id", update.UpdateID),
+		slog.Int("attempts", p.attempts),
+	)
+	if p.OnFailure != nil {
+		p.OnFailure(update, err)
+	}
+
+	return true
+}
+
+// commit advances the offset past a handled update and persists it.
+// The offset is saved even if ctx was cancelled meanwhile, since the update was handled.
+func (p *Poller) commit(ctx context.Context, offset int) {
+	p.config.Offset = offset
+	if p.Store == nil {
+		return
+	}
+
+	if err := p.Store.Save(context.WithoutCancel(ctx), offset); err != nil {
+		p.api.logger().Error("failed to save update offset",
+			slog.String("error", err.Error()),
+			slog.Int("offset", offset),
+		)
+	}
+}
+
+// confirm tells Telegram that updates before the current offset were handled, so they are not sent again.
+func (p *Poller) confirm(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), confirmTimeout)
+	defer cancel()
+
+	_, err := p.api.GetUpdatesCtx(ctx, &types.GetUpdates{Offset: p.config.Offset, Limit: 1})
+	if err != nil {
+		p.api.logger().Error("failed to confirm update offset",
+			slog.String("error", err.Error()),
+			slog.Int("offset", p.config.Offset),
+		)
+	}
+}
+
+// backoff returns the delay before the next attempt, honouring retry_after of a 429 response.
+func (p *Poller) backoff(failures int, err error) time.Duration {
+	if retryAfter, ok := types.RetryAfter(err); ok {
+		return retryAfter
+	}
+
+	minDelay := p.MinBackoff
+	if minDelay <= 0 {
+		minDelay = time.Second
+	}
+	maxDelay := p.MaxBackoff
+	if maxDelay <= 0 {
+		maxDelay = time.Minute
+	}
+
+	return backoff(&types.RetryPolicy{BaseDelay: minDelay, MaxDelay: maxDelay}, failures)
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,86 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// fakeUpdates answers getUpdates with the updates from offset on, like Telegram does.
+func fakeUpdates(updates ...types.Update) InterceptorFunc {
+	return func(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile, next Invoker) (*types.APIResponse, error) {
+		offset, _ := strconv.Atoi(params["offset"])
+		pending := []types.Update{}
+		for _, update := range updates {
+			if update.UpdateID >= offset {
+				pending = append(pending, update)
+			}
+		}
+		result, err := json.Marshal(pending)
+		if err != nil {
+			return nil, err
+		}
+		if len(pending) == 0 {
+			sleep(ctx, 10*time.Millisecond)
+		}
+
+		return &types.APIResponse{Ok: true, Result: result}, nil
+	}
+}
+
+func TestPollerSkipsFailingUpdates(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		err          error
+		wantAttempts int
+	}{
+		{"handler error", 3, errors.New("failed"), 3},
+		{"default attempts", 0, errors.New("failed"), defaultMaxAttempts},
+		{"panic", 3, &PanicError{Value: "boom"}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &Api{Bot: &types.BotApi{}}
+			api.Use(fakeUpdates(types.Update{UpdateID: 1}, types.Update{UpdateID: 2}))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			attempts := 0
+			var skipped []int
+			poller := api.NewPoller(api.NewGetUpdates(), func(ctx context.Context, update types.Update) error {
+				if update.UpdateID == 1 {
+					attempts++
+					return tt.err
+				}
+				cancel()
+				return nil
+			})
+			poller.MinBackoff = time.Millisecond
+			poller.MaxBackoff = time.Millisecond
+			poller.MaxAttempts = tt.maxAttempts
+			poller.OnFailure = func(update types.Update, err error) {
+				skipped = append(skipped, update.UpdateID)
+			}
+			poller.Start(ctx)
+			poller.Wait()
+
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if len(skipped) != 1 || skipped[0] != 1 {
+				t.Errorf("skipped = %v, want [1]", skipped)
+			}
+			if poller.config.Offset != 3 {
+				t.Errorf("offset = %d, want 3", poller.config.Offset)
+			}
+		})
+	}
+}
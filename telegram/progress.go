@@ -0,0 +1,174 @@
+package telegram
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/config"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// chatActionInterval is how often the chat action is repeated, Telegram clears it after 5 seconds.
+const chatActionInterval = 4 * time.Second
+
+type uploadProgressKey struct{}
+
+type chatActionKey struct{}
+
+// WithUploadProgress returns a context reporting the progress of multipart uploads made with it.
+// The callback receives the bytes written for all files of the request and their total size, or -1 if unknown.
+func WithUploadProgress(ctx context.Context, progress types.ProgressFunc) context.Context {
+	return context.WithValue(ctx, uploadProgressKey{}, progress)
+}
+
+// WithUploadChatAction returns a context that keeps sending a chat action to the target chat
+// while multipart uploads made with it are running.
+// If action is empty, it is chosen from the endpoint, for example upload_video for sendVideo.
+func WithUploadChatAction(ctx context.Context, action string) context.Context {
+	return context.WithValue(ctx, chatActionKey{}, action)
+}
+
+// uploadProgress tracks the bytes written for the files of one upload.
+type uploadProgress struct {
+	request types.ProgressFunc
+	files   []types.RequestFile
+	totals  []int64
+	total   int64
+	written int64
+}
+
+func newUploadProgress(ctx context.Context, files []types.RequestFile) *uploadProgress {
+	request, _ := ctx.Value(uploadProgressKey{}).(types.ProgressFunc)
+
+	p := &uploadProgress{
+		request: request,
+		files:   files,
+		totals:  make([]int64, len(files)),
+	}
+	if request == nil && !hasFileProgress(files) {
+		return p
+	}
+
+	for i, file := range files {
+		p.totals[i] = -1
+		if !file.Data.NeedsUpload() {
+			p.totals[i] = 0
+			continue
+		}
+		if size, ok := fileSize(file.Data); ok {
+			p.totals[i] = size
+		}
+		if p.total != -1 && p.totals[i] != -1 {
+			p.total += p.totals[i]
+		} else {
+			p.total = -1
+		}
+	}
+
+	return p
+}
+
+func hasFileProgress(files []types.RequestFile) bool {
+	for _, file := range files {
+		if file.Progress != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reader wraps the reader of file i, reporting progress as it is read.
+func (p *uploadProgress) reader(i int, r io.Reader) io.Reader {
+	if p.request == nil && p.files[i].Progress == nil {
+		return r
+	}
+
+	return &progressReader{Reader: r, progress: p, index: i}
+}
+
+type progressReader struct {
+	io.Reader
+	progress *uploadProgress
+	index    int
+	written  int64
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	if n > 0 {
+		r.written += int64(n)
+		r.progress.written += int64(n)
+
+		if fn := r.progress.files[r.index].Progress; fn != nil {
+			fn(r.written, r.progress.totals[r.index])
+		}
+		if r.progress.request != nil {
+			r.progress.request(r.progress.written, r.progress.total)
+		}
+	}
+
+	return n, err
+}
+
+// keepChatAction sends action to the chat of the request until the returned stop function is called.
+func (t *Api) keepChatAction(ctx context.Context, endpoint string, params types.Params, action string) (stop func()) {
+	chatID := params["chat_id"]
+	if action == "" {
+		action = uploadChatAction(endpoint)
+	}
+	if chatID == "" || action == "" {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var done atomic.Bool
+
+	go func() {
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+
+		for {
+			actionParams := types.Params{"chat_id": chatID, "action": action}
+			if thread := params["message_thread_id"]; thread != "" {
+				actionParams["message_thread_id"] = thread
+			}
+			if _, err := t.MakeRequestCtx(ctx, config.EndpointSendChatAction, actionParams); err != nil && !done.Load() {
+				t.logger().LogAttrs(ctx, slog.LevelWarn, "failed to send chat action",
+					slog.String("chat_id", chatID),
+					slog.String("error", err.Error()),
+				)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		done.Store(true)
+		cancel()
+	}
+}
+
+// uploadChatAction returns the chat action matching an upload endpoint.
+func uploadChatAction(endpoint string) string {
+	switch endpoint {
+	case config.EndpointSendPhoto:
+		return config.ChatUploadPhoto
+	case config.EndpointSendVideo:
+		return config.ChatUploadVideo
+	case config.EndpointSendVoice:
+		return config.ChatUploadVoice
+	case config.EndpointSendVideoNote:
+		return config.ChatUploadVideoNote
+	default:
+		return config.ChatUploadDocument
+	}
+}
@@ -0,0 +1,320 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+var (
+	// ErrQueueFull is returned by UpdateQueue.Push when the queue is full and the overflow policy rejects updates.
+	ErrQueueFull = errors.New("update queue is full")
+	// ErrQueueClosed is returned by UpdateQueue.Push when the queue is not running.
+	ErrQueueClosed = errors.New("update queue is closed")
+)
+
+const (
+	defaultQueueSize = 100
+	spillInterval    = time.Second
+)
+
+// Overflow decides what happens to an update pushed to a full UpdateQueue.
+type Overflow int
+
+const (
+	OverflowBlock  Overflow = iota // wait until there is room in the queue
+	OverflowReject                 // return ErrQueueFull, a webhook answers 503 and Telegram delivers the update again later
+	OverflowSpill                  // write the update to SpillDir, it is queued again once there is room
+)
+
+// QueueStats is a snapshot of the state of an UpdateQueue.
+type QueueStats struct {
+	Depth    int    // updates waiting in memory
+	Capacity int    // size of the in-memory queue
+	Spilled  int64  // updates waiting on disk
+	Handled  uint64 // updates handled without error
+	Failed   uint64 // updates whose handler returned an error
+	Rejected uint64 // updates rejected because the queue was full
+}
+
+// UpdateQueue is a bounded in-process queue of updates drained by a fixed number of workers.
+// Pass its Push method to NewWebhookHandler to answer webhook requests before the update is handled.
+type UpdateQueue struct {
+	Size     int      // Optional. Number of updates kept in memory. Defaults to 100
+	Workers  int      // Optional. Number of updates handled concurrently. Defaults to the number of CPUs
+	Overflow Overflow // Optional. What to do with updates pushed to a full queue. Defaults to OverflowBlock
+	SpillDir string   // Required for OverflowSpill. Directory spilled updates are written to, left over files are queued again on Start
+
+	api      *Api
+	handler  UpdateHandler
+	updates  chan types.Update
+	stop     chan struct{}
+	spilled  chan struct{}
+	mu       sync.RWMutex
+	running  bool
+	start    sync.Once
+	startErr error
+	workers  sync.WaitGroup
+	done     chan struct{}
+	spills   atomic.Int64
+	handled  atomic.Uint64
+	failed   atomic.Uint64
+	rejected atomic.Uint64
+}
+
+// NewUpdateQueue returns an UpdateQueue passing every update to handler.
+func (t *Api) NewUpdateQueue(handler UpdateHandler) *UpdateQueue {
+	return &UpdateQueue{
+		api:     t,
+		handler: handler,
+		stop:    make(chan struct{}),
+		spilled: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start runs the workers until ctx is cancelled. Updates already queued in memory are still handled,
+// the handlers get a context that is not cancelled with ctx.
+// If the queue can not be started, every call returns the same error and Wait returns right away.
+func (q *UpdateQueue) Start(ctx context.Context) error {
+	q.start.Do(func() {
+		q.startErr = q.run(ctx)
+		if q.startErr != nil {
+			close(q.done)
+		}
+	})
+
+	return q.startErr
+}
+
+// Wait blocks until the queue stopped and every update taken from it has been handled.
+func (q *UpdateQueue) Wait() {
+	<-q.done
+}
+
+// Push adds update to the queue, applying the overflow policy if the queue is full.
+func (q *UpdateQueue) Push(ctx context.Context, update types.Update) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if !q.running {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.updates <- update:
+		return nil
+	default:
+	}
+
+	switch q.Overflow {
+	case OverflowReject:
+		q.rejected.Add(1)
+		return ErrQueueFull
+	case OverflowSpill:
+		return q.spill(update)
+	}
+
+	select {
+	case q.updates <- update:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-q.stop:
+		return ErrQueueClosed
+	}
+}
+
+// Stats returns the current queue depth and counters.
+func (q *UpdateQueue) Stats() QueueStats {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return QueueStats{
+		Depth:    len(q.updates),
+		Capacity: cap(q.updates),
+		Spilled:  q.spills.Load(),
+		Handled:  q.handled.Load(),
+		Failed:   q.failed.Load(),
+		Rejected: q.rejected.Load(),
+	}
+}
+
+func (q *UpdateQueue) run(ctx context.Context) error {
+	if q.Overflow == OverflowSpill {
+		if q.SpillDir == "" {
+			return errors.New("SpillDir Required")
+		}
+		if err := os.MkdirAll(q.SpillDir, 0o755); err != nil {
+			return err
+		}
+		files, err := q.spillFiles()
+		if err != nil {
+			return err
+		}
+		q.spills.Store(int64(len(files)))
+	}
+
+	size := q.Size
+	if size <= 0 {
+		size = defaultQueueSize
+	}
+	workers := q.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	q.mu.Lock()
+	q.updates = make(chan types.Update, size)
+	q.running = true
+	q.mu.Unlock()
+
+	handlerCtx := context.WithoutCancel(ctx)
+	for i := 0; i < workers; i++ {
+		q.workers.Add(1)
+		go q.work(handlerCtx)
+	}
+
+	var drain sync.WaitGroup
+	if q.Overflow == OverflowSpill {
+		drain.Add(1)
+		go func() {
+			defer drain.Done()
+			q.drain(ctx)
+		}()
+	}
+
+	go func() {
+		<-ctx.Done()
+		close(q.stop)
+		drain.Wait()
+
+		q.mu.Lock()
+		q.running = false
+		close(q.updates)
+		q.mu.Unlock()
+
+		q.workers.Wait()
+		close(q.done)
+	}()
+
+	return nil
+}
+
+func (q *UpdateQueue) work(ctx context.Context) {
+	defer q.workers.Done()
+
+	for update := range q.updates {
+		if err := q.handler(ctx, update); err != nil {
+			q.failed.Add(1)
+			q.api.logger().Error("failed to handle update",
+				slog.String("error", err.Error()),
+				slog.Int("update_id", update.UpdateID),
+			)
+		} else {
+			q.handled.Add(1)
+		}
+
+		if q.spills.Load() > 0 {
+			select {
+			case q.spilled <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// spill writes update to SpillDir, so it survives until there is room in the queue.
+func (q *UpdateQueue) spill(update types.Update) error {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	name := filepath.Join(q.SpillDir, fmt.Sprintf("%020d.json", update.UpdateID))
+	tmp := name + ".tmp"
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, name); err != nil {
+		return err
+	}
+	q.spills.Add(1)
+
+	return nil
+}
+
+// drain moves spilled updates back into the queue whenever a worker made room, oldest first.
+func (q *UpdateQueue) drain(ctx context.Context) {
+	ticker := time.NewTicker(spillInterval)
+	defer ticker.Stop()
+
+	for {
+		files, err := q.spillFiles()
+		if err != nil {
+			q.api.logger().Error("failed to read spilled updates", slog.String("error", err.Error()))
+		}
+
+		for _, file := range files {
+			update, err := readSpilled(file)
+			if err != nil {
+				q.api.logger().Error("failed to read spilled update, file is set aside",
+					slog.String("error", err.Error()),
+					slog.String("file", file),
+				)
+				_ = os.Rename(file, file+".bad")
+				q.spills.Add(-1)
+				continue
+			}
+
+			select {
+			case q.updates <- update:
+			case <-ctx.Done():
+				return
+			}
+			_ = os.Remove(file)
+			q.spills.Add(-1)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.spilled:
+		case <-ticker.C:
+		}
+	}
+}
+
+// spillFiles returns the spilled updates in SpillDir ordered by update id.
+func (q *UpdateQueue) spillFiles() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(q.SpillDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func readSpilled(file string) (types.Update, error) {
+	var update types.Update
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return update, err
+	}
+	err = json.Unmarshal(data, &update)
+
+	return update, err
+}
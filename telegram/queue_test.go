@@ -0,0 +1,91 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestUpdateQueueFailedStart(t *testing.T) {
+	api := &Api{Bot: &types.BotApi{}}
+	queue := api.NewUpdateQueue(func(ctx context.Context, update types.Update) error {
+		return nil
+	})
+	queue.Overflow = OverflowSpill
+
+	ctx := context.Background()
+	first := queue.Start(ctx)
+	if first == nil {
+		t.Fatal("Start() without SpillDir succeeded")
+	}
+	if err := queue.Start(ctx); err != first {
+		t.Errorf("second Start() = %v, want %v", err, first)
+	}
+	if err := queue.Push(ctx, types.Update{UpdateID: 1}); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Push() = %v, want ErrQueueClosed", err)
+	}
+
+	waited := make(chan struct{})
+	go func() {
+		queue.Wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		t.Error("Wait() blocked after a failed start")
+	}
+}
+
+func TestUpdateQueueHandles(t *testing.T) {
+	tests := []struct {
+		name     string
+		overflow Overflow
+		spill    bool
+	}{
+		{"block", OverflowBlock, false},
+		{"reject", OverflowReject, false},
+		{"spill", OverflowSpill, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &Api{Bot: &types.BotApi{}}
+			handled := make(chan int, 10)
+			queue := api.NewUpdateQueue(func(ctx context.Context, update types.Update) error {
+				handled <- update.UpdateID
+				if update.UpdateID == 2 {
+					return errors.New("failed")
+				}
+				return nil
+			})
+			queue.Overflow = tt.overflow
+			if tt.spill {
+				queue.SpillDir = t.TempDir()
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if err := queue.Start(ctx); err != nil {
+				t.Fatal(err)
+			}
+			for id := 1; id <= 3; id++ {
+				if err := queue.Push(ctx, types.Update{UpdateID: id}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i := 0; i < 3; i++ {
+				<-handled
+			}
+			cancel()
+			queue.Wait()
+
+			stats := queue.Stats()
+			if stats.Handled != 2 || stats.Failed != 1 {
+				t.Errorf("Stats() = %+v, want 2 handled and 1 failed", stats)
+			}
+		})
+	}
+}
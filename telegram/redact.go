@@ -0,0 +1,49 @@
+package telegram
+
+import (
+	"net/url"
+	"strings"
+)
+
+const redactedToken = "<redacted>"
+
+// redactedError hides the bot token from the message of the wrapped error.
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redact returns err with every occurrence of the bot token removed from its message.
+// A *url.Error keeps its type, so errors.As and Timeout checks keep working.
+func (t *Api) redact(err error) error {
+	if err == nil || t.Bot.Token == "" || !strings.Contains(err.Error(), t.Bot.Token) {
+		return err
+	}
+
+	if urlErr, ok := err.(*url.Error); ok {
+		return &url.Error{
+			Op:  urlErr.Op,
+			URL: t.redactString(urlErr.URL),
+			Err: t.redact(urlErr.Err),
+		}
+	}
+
+	return &redactedError{err: err, msg: t.redactString(err.Error())}
+}
+
+// redactString replaces the bot token in s.
+func (t *Api) redactString(s string) string {
+	if t.Bot.Token == "" {
+		return s
+	}
+
+	return strings.ReplaceAll(s, t.Bot.Token, redactedToken)
+}
@@ -0,0 +1,211 @@
+package telegram
+
+import (
+	"io"
+	"math"
+	"os"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+// uploadReplay hands out the files of an upload so that every attempt reads them from the start.
+type uploadReplay struct {
+	files      []types.RequestFile
+	opens      []func() (io.Reader, error) // per file, nil if UploadData can simply be called again
+	closers    []io.Closer
+	temp       []*os.File
+	replayable bool
+}
+
+// prepareReplay makes files replayable when the bot has a retry policy.
+// Readers are rewound through io.Seeker or io.ReaderAt, other sources are copied to a temporary file if SpoolUploads is set.
+// If any file cannot be replayed, the upload is sent once with files unchanged.
+func (t *Api) prepareReplay(files []types.RequestFile) (*uploadReplay, error) {
+	if t.Bot.Retry == nil {
+		return &uploadReplay{files: files}, nil
+	}
+
+	return t.replayFiles(files)
+}
+
+// replayFiles makes files replayable regardless of the retry policy, it is used to re-issue an upload after a migration.
+func (t *Api) replayFiles(files []types.RequestFile) (*uploadReplay, error) {
+	replay := &uploadReplay{files: files}
+	if !t.canReplay(files) {
+		return replay, nil
+	}
+
+	replay.opens = make([]func() (io.Reader, error), len(files))
+	replay.files = make([]types.RequestFile, len(files))
+	copy(replay.files, files)
+
+	for i, file := range files {
+		if !file.Data.NeedsUpload() {
+			continue
+		}
+
+		if fr, ok := fileReader(file.Data); ok && fr.CanReplay() {
+			if err := replay.rewind(i, fr); err != nil {
+				replay.close()
+				return nil, err
+			}
+			continue
+		}
+
+		if data, ok := file.Data.(types.ReplayableFileData); ok && data.CanReplay() {
+			continue
+		}
+
+		if err := replay.spool(i, file.Data); err != nil {
+			replay.close()
+			return nil, err
+		}
+	}
+	replay.replayable = true
+
+	return replay, nil
+}
+
+// canReplay reports whether every file of an upload can be sent again, possibly after spooling.
+func (t *Api) canReplay(files []types.RequestFile) bool {
+	if t.Bot.SpoolUploads {
+		return true
+	}
+
+	for _, file := range files {
+		if !file.Data.NeedsUpload() {
+			continue
+		}
+		if data, ok := file.Data.(types.ReplayableFileData); !ok || !data.CanReplay() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rewind remembers how to read fr from offset 0 again, see types.ReplayableFileData.
+func (r *uploadReplay) rewind(i int, fr types.FileReader) error {
+	size := fr.Size
+
+	switch reader := fr.Reader.(type) {
+	case io.ReadSeeker:
+		if size <= 0 {
+			end, err := reader.Seek(0, io.SeekEnd)
+			if err != nil {
+				return err
+			}
+			size = end
+		}
+		r.opens[i] = func() (io.Reader, error) {
+			if _, err := reader.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return keepOpen{reader}, nil
+		}
+	case io.ReaderAt:
+		if size <= 0 {
+			size, _ = readerSize(fr)
+		}
+		n := size
+		if n <= 0 {
+			n = math.MaxInt64
+		}
+		r.opens[i] = func() (io.Reader, error) {
+			return io.NewSectionReader(reader, 0, n), nil
+		}
+	}
+
+	if closer, ok := fr.Reader.(io.Closer); ok {
+		r.closers = append(r.closers, closer)
+	}
+	r.files[i].Data = types.FileReader{Name: fr.Name, Reader: fr.Reader, Size: size}
+
+	return nil
+}
+
+// spool copies data to a temporary file that is read again on every attempt.
+func (r *uploadReplay) spool(i int, data types.RequestFileData) error {
+	name, reader, err := data.UploadData()
+	if err != nil {
+		return err
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer func(closer io.Closer) {
+			_ = closer.Close()
+		}(closer)
+	}
+
+	file, err := os.CreateTemp("", "telegram-upload-*")
+	if err != nil {
+		return err
+	}
+	r.temp = append(r.temp, file)
+
+	size, err := io.Copy(file, reader)
+	if err != nil {
+		return err
+	}
+
+	r.opens[i] = func() (io.Reader, error) {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return keepOpen{file}, nil
+	}
+	r.files[i].Data = types.FileReader{Name: name, Reader: file, Size: size}
+
+	return nil
+}
+
+// next returns the files for the next attempt.
+func (r *uploadReplay) next() ([]types.RequestFile, error) {
+	if r.opens == nil {
+		return r.files, nil
+	}
+
+	files := make([]types.RequestFile, len(r.files))
+	copy(files, r.files)
+	for i, open := range r.opens {
+		if open == nil {
+			continue
+		}
+		reader, err := open()
+		if err != nil {
+			return nil, err
+		}
+		fr := files[i].Data.(types.FileReader)
+		files[i].Data = types.FileReader{Name: fr.Name, Reader: reader, Size: fr.Size}
+	}
+
+	return files, nil
+}
+
+// close releases the readers kept open between attempts and removes spooled files.
+func (r *uploadReplay) close() {
+	for _, closer := range r.closers {
+		_ = closer.Close()
+	}
+	for _, file := range r.temp {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}
+}
+
+// keepOpen hides Close, so uploadFiles leaves the reader open for the next attempt.
+// It stays seekable, so a retry inside a re-issued upload can rewind it again.
+type keepOpen struct {
+	io.ReadSeeker
+}
+
+// fileReader returns data as FileReader if it is one.
+func fileReader(data types.RequestFileData) (types.FileReader, bool) {
+	switch d := data.(type) {
+	case types.FileReader:
+		return d, true
+	case *types.FileReader:
+		return *d, true
+	}
+
+	return types.FileReader{}, false
+}
@@ -0,0 +1,65 @@
+package telegram
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestUploadReplayFromStart(t *testing.T) {
+	tests := []struct {
+		name   string
+		reader func() io.Reader
+		size   int64
+	}{
+		{"seeker", func() io.Reader { return bytes.NewReader([]byte("content")) }, 0},
+		{"partly read seeker", func() io.Reader {
+			r := bytes.NewReader([]byte("content"))
+			_, _ = r.Read(make([]byte, 3))
+			return r
+		}, 0},
+		{"partly read reader at", func() io.Reader {
+			r := strings.NewReader("content")
+			_, _ = r.Read(make([]byte, 3))
+			//hide Seek so the reader is rewound through io.ReaderAt
+			return struct {
+				io.Reader
+				io.ReaderAt
+			}{r, r}
+		}, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &Api{Bot: &types.BotApi{Retry: &types.RetryPolicy{}}}
+			files := []types.RequestFile{{Name: "document", Data: types.FileReader{Name: "a.txt", Reader: tt.reader(), Size: tt.size}}}
+
+			replay, err := api.prepareReplay(files)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer replay.close()
+			if !replay.replayable {
+				t.Fatal("upload is not replayable")
+			}
+
+			for attempt := 1; attempt <= 2; attempt++ {
+				next, err := replay.next()
+				if err != nil {
+					t.Fatal(err)
+				}
+				fr := next[0].Data.(types.FileReader)
+				data, err := io.ReadAll(fr.Reader)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(data) != "content" || fr.Size != int64(len("content")) {
+					t.Errorf("attempt %d read %q with size %d, want %q with size 7", attempt, data, fr.Size, "content")
+				}
+			}
+		})
+	}
+}
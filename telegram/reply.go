@@ -0,0 +1,107 @@
+package telegram
+
+import (
+	"context"
+	"sync"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+type responderKey struct{}
+
+// responder holds the API call answered in the body of a webhook response.
+type responder struct {
+	api    *Api
+	mu     sync.Mutex
+	held   types.Chattable
+	body   []byte
+	closed bool
+}
+
+// Reply sends c as the answer to the webhook request carried by ctx, saving a round trip to Telegram.
+// The first reply without file uploads, made before the handler returns, is held and written to the response.
+// Every other call, and any call outside a WebhookHandler, is sent with RequestCtx instead.
+// A second reply sends the held one first, so replies reach Telegram in the order they were made.
+// If the handler returns an error, the held reply is sent with RequestCtx before the error is answered.
+// Replies written to the response do not pass the interceptors, and Telegram does not report their result.
+func (t *Api) Reply(ctx context.Context, c types.Chattable) error {
+	if r, ok := ctx.Value(responderKey{}).(*responder); ok && r.api == t {
+		held, flush, err := r.reply(c)
+		if held || err != nil {
+			return err
+		}
+		if flush != nil {
+			if _, err = t.RequestCtx(ctx, flush); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := t.RequestCtx(ctx, c)
+
+	return err
+}
+
+// reply stores c for the response, it returns false if c has to be sent as a normal request.
+// A reply held before is returned as flush and has to be sent ahead of c, the response stays empty then.
+func (r *responder) reply(c types.Chattable) (held bool, flush types.Chattable, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return false, nil, nil
+	}
+	if r.body != nil {
+		flush = r.held
+		r.held, r.body, r.closed = nil, nil, true
+		return false, flush, nil
+	}
+
+	params, err := c.Params()
+	if err != nil {
+		return false, nil, err
+	}
+	if f, ok := c.(types.Fileable); ok {
+		files := f.Files()
+		if hasFilesNeedingUpload(files) {
+			return false, nil, nil
+		}
+		for _, file := range files {
+			params[file.Name] = file.Data.SendData()
+		}
+	}
+
+	params["method"] = c.EndPoint()
+	body, err := jsonBody(params)
+	if err != nil {
+		return false, nil, err
+	}
+	r.held, r.body = c, body
+
+	return true, nil, nil
+}
+
+// close stops accepting replies and returns the JSON encoded reply, if any.
+func (r *responder) close() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+
+	return r.body
+}
+
+// send sends the held reply as a normal request, for a response that can not carry it.
+func (r *responder) send(ctx context.Context) error {
+	r.mu.Lock()
+	held := r.held
+	r.held, r.body = nil, nil
+	r.mu.Unlock()
+
+	if held == nil {
+		return nil
+	}
+	_, err := r.api.RequestCtx(ctx, held)
+
+	return err
+}
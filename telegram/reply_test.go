@@ -0,0 +1,71 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestReplyOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		replies    []string
+		err        error
+		wantStatus int
+		wantSent   []string
+		wantBody   string
+	}{
+		{"single reply in response", []string{"a"}, nil, http.StatusOK, nil, "a"},
+		{"held reply sent first", []string{"a", "b"}, nil, http.StatusOK, []string{"a", "b"}, ""},
+		{"all replies in order", []string{"a", "b", "c"}, nil, http.StatusOK, []string{"a", "b", "c"}, ""},
+		{"held reply sent on handler error", []string{"a"}, errors.New("failed"), http.StatusInternalServerError, []string{"a"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &Api{Bot: &types.BotApi{}}
+			var sent []string
+			api.Use(InterceptorFunc(func(ctx context.Context, endpoint string, params types.Params, files []types.RequestFile, next Invoker) (*types.APIResponse, error) {
+				sent = append(sent, endpoint)
+				return &types.APIResponse{Ok: true, Result: json.RawMessage("true")}, nil
+			}))
+
+			handler := api.NewWebhookHandler(func(ctx context.Context, update types.Update) error {
+				for _, method := range tt.replies {
+					if err := api.Reply(ctx, types.RawRequest{Method: method}); err != nil {
+						return err
+					}
+				}
+				return tt.err
+			})
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"update_id":1}`)))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(sent, tt.wantSent) {
+				t.Errorf("sent = %v, want %v", sent, tt.wantSent)
+			}
+			var body struct {
+				Method string `json:"method"`
+			}
+			if w.Code == http.StatusOK && w.Body.Len() > 0 {
+				if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if body.Method != tt.wantBody {
+				t.Errorf("response method = %q, want %q", body.Method, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+const (
+	defaultRetryAttempts  = 3
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+// nonIdempotentPrefixes lists endpoint prefixes whose calls must not be repeated blindly,
+// since a retry after a lost response would perform the action twice.
+var nonIdempotentPrefixes = []string{
+	"send", "forward", "copy", "create", "upload", "add", "export", "revoke", "refund",
+}
+
+// requestFunc performs a single attempt of an API call.
+type requestFunc func(ctx context.Context) (*types.APIResponse, error)
+
+// withRetry runs do and repeats it according to the bot retry policy.
+// replayable reports whether the request body can be sent more than once.
+func (t *Api) withRetry(ctx context.Context, endpoint string, replayable bool, do requestFunc) (*types.APIResponse, error) {
+	policy := t.Bot.Retry
+	if policy == nil || !replayable {
+		return do(ctx)
+	}
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = defaultRetryAttempts
+	}
+
+	var waited time.Duration
+	for attempt := 1; ; attempt++ {
+		resp, err := do(ctx)
+		if err == nil || attempt >= maxAttempts || ctx.Err() != nil || !retryAllowed(policy, endpoint, err) {
+			return resp, err
+		}
+
+		delay, ok := retryDelay(policy, attempt, err)
+		if !ok {
+			return resp, err
+		}
+		if policy.MaxWait > 0 && waited+delay > policy.MaxWait {
+			return resp, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+
+		t.logger().LogAttrs(ctx, slog.LevelWarn, "retrying request",
+			slog.String("endpoint", endpoint),
+			slog.Int("attempt", attempt),
+			slog.Duration("delay", delay),
+			slog.String("error", err.Error()),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+		waited += delay
+	}
+}
+
+// retryAllowed reports whether the policy permits retrying endpoint after err.
+func retryAllowed(policy *types.RetryPolicy, endpoint string, err error) bool {
+	if len(policy.Endpoints) != 0 {
+		for _, e := range policy.Endpoints {
+			if e == endpoint {
+				return true
+			}
+		}
+		return false
+	}
+
+	if policy.RetryNonIdempotent || isIdempotent(endpoint) {
+		return true
+	}
+
+	// A 429 response means the call was rejected before it took effect.
+	_, limited := types.RetryAfter(err)
+	return limited
+}
+
+// isIdempotent reports whether repeating a call to endpoint has the same effect as calling it once.
+func isIdempotent(endpoint string) bool {
+	for _, prefix := range nonIdempotentPrefixes {
+		if strings.HasPrefix(endpoint, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// retryDelay returns how long to wait before the next attempt, or false if err is not retryable.
+func retryDelay(policy *types.RetryPolicy, attempt int, err error) (time.Duration, bool) {
+	if retryAfter, ok := types.RetryAfter(err); ok {
+		return retryAfter, true
+	}
+
+	if apiErr, ok := types.AsError(err); ok {
+		switch {
+		case apiErr.Code >= 500:
+			return backoff(policy, attempt), true
+		default:
+			return 0, false
+		}
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return backoff(policy, attempt), true
+	}
+
+	return 0, false
+}
+
+// backoff returns a jittered exponential delay for the given attempt.
+func backoff(policy *types.RetryPolicy, attempt int) time.Duration {
+	base := policy.BaseDelay
+	if base <= 0 {
+		base = defaultRetryBaseDelay
+	}
+	maxDelay := policy.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,132 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestRetryAllowed(t *testing.T) {
+	serverErr := &types.Error{Code: 502, Message: "Bad Gateway"}
+	floodErr := &types.Error{Code: 429, Message: "Too Many Requests", ResponseParameters: types.ResponseParameters{RetryAfter: 3}}
+
+	tests := []struct {
+		name     string
+		policy   types.RetryPolicy
+		endpoint string
+		err      error
+		want     bool
+	}{
+		{"idempotent", types.RetryPolicy{}, "getChat", serverErr, true},
+		{"non-idempotent", types.RetryPolicy{}, "sendMessage", serverErr, false},
+		{"non-idempotent flood control", types.RetryPolicy{}, "sendMessage", floodErr, true},
+		{"non-idempotent allowed", types.RetryPolicy{RetryNonIdempotent: true}, "copyMessage", serverErr, true},
+		{"listed endpoint", types.RetryPolicy{Endpoints: []string{"sendMessage"}}, "sendMessage", serverErr, true},
+		{"unlisted endpoint", types.RetryPolicy{Endpoints: []string{"sendMessage"}}, "getChat", serverErr, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryAllowed(&tt.policy, tt.endpoint, tt.err); got != tt.want {
+				t.Errorf("retryAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := &types.RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		name     string
+		err      error
+		retry    bool
+		min, max time.Duration
+	}{
+		{"flood control", &types.Error{Code: 429, ResponseParameters: types.ResponseParameters{RetryAfter: 7}}, true, 7 * time.Second, 7 * time.Second},
+		{"server error", &types.Error{Code: 500}, true, 50 * time.Millisecond, 100 * time.Millisecond},
+		{"bad request", &types.Error{Code: 400, Message: "Bad Request: chat not found"}, false, 0, 0},
+		{"forbidden", &types.Error{Code: 403}, false, 0, 0},
+		{"network error", &url.Error{Op: "Post", URL: "https://api.telegram.org", Err: errors.New("connection reset")}, true, 50 * time.Millisecond, 100 * time.Millisecond},
+		{"other error", errors.New("invalid params"), false, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := retryDelay(policy, 1, tt.err)
+			if retry != tt.retry {
+				t.Fatalf("retryDelay() retry = %v, want %v", retry, tt.retry)
+			}
+			if delay < tt.min || delay > tt.max {
+				t.Errorf("retryDelay() = %v, want between %v and %v", delay, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := &types.RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{5, time.Second},
+		{50, time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			if delay := backoff(policy, tt.attempt); delay < tt.max/2 || delay > tt.max {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, delay, tt.max/2, tt.max)
+			}
+		}
+	}
+}
+
+func TestWithRetryAttempts(t *testing.T) {
+	serverErr := &types.Error{Code: 500}
+
+	tests := []struct {
+		name       string
+		policy     *types.RetryPolicy
+		endpoint   string
+		replayable bool
+		errs       []error
+		want       int
+	}{
+		{"no policy", nil, "getChat", true, []error{serverErr, nil}, 1},
+		{"recovers", &types.RetryPolicy{}, "getChat", true, []error{serverErr, serverErr, nil}, 3},
+		{"gives up after max attempts", &types.RetryPolicy{MaxAttempts: 2}, "getChat", true, []error{serverErr, serverErr, nil}, 2},
+		{"not replayable", &types.RetryPolicy{}, "getChat", false, []error{serverErr, nil}, 1},
+		{"not retryable", &types.RetryPolicy{}, "getChat", true, []error{&types.Error{Code: 400}, nil}, 1},
+		{"non-idempotent", &types.RetryPolicy{}, "sendMessage", true, []error{serverErr, nil}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.policy != nil {
+				tt.policy.BaseDelay = time.Millisecond
+			}
+			api := &Api{Bot: &types.BotApi{Retry: tt.policy}}
+
+			attempts := 0
+			_, _ = api.withRetry(context.Background(), tt.endpoint, tt.replayable, func(ctx context.Context) (*types.APIResponse, error) {
+				err := tt.errs[attempts]
+				attempts++
+				return nil, err
+			})
+
+			if attempts != tt.want {
+				t.Errorf("attempts = %d, want %d", attempts, tt.want)
+			}
+		})
+	}
+}
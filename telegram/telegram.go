@@ -4,17 +4,25 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"log/slog"
 	"net/http"
+	"sync"
 
 	"github.com/raminsa/telegram-bot-api/client"
 	"github.com/raminsa/telegram-bot-api/config"
 	"github.com/raminsa/telegram-bot-api/types"
 )
 
-var Core Api
-
+// Api is a Telegram bot. Every constructor returns an independent instance,
+// so one process can run many bots with different tokens.
 type Api struct {
-	Bot *types.BotApi
+	Bot          *types.BotApi
+	interceptors []Interceptor
+	ackID        int
+	ackSignal    chan struct{}
+	debugLogger  *slog.Logger
+	debugOnce    sync.Once
+	mu           sync.Mutex
 }
 
 // BaseUrl set custom api base url.
@@ -41,8 +49,7 @@ func New(token string) (*Api, error) {
 		return nil, err
 	}
 
-	Core.Bot = &types.BotApi{Token: token, BaseUrl: c.BaseUrl, Client: c.HttpC}
-	return &Core, nil
+	return &Api{Bot: &types.BotApi{Token: token, BaseUrl: c.BaseUrl, Client: c.HttpC}}, nil
 }
 
 // NewWithBaseUrl make new telegram bot api response with custom base url.
@@ -62,8 +69,7 @@ func NewWithBaseUrl(token, baseUrl string) (*Api, error) {
 		return nil, err
 	}
 
-	Core.Bot = &types.BotApi{Token: token, BaseUrl: c.BaseUrl, Client: c.HttpC}
-	return &Core, nil
+	return &Api{Bot: &types.BotApi{Token: token, BaseUrl: c.BaseUrl, Client: c.HttpC}}, nil
 }
 
 // NewWithCustomClient make new telegram bot api response with a custom client.
@@ -80,8 +86,7 @@ func NewWithCustomClient(token string, c *client.Config) (*Api, error) {
 		return nil, err
 	}
 
-	Core.Bot = &types.BotApi{Token: token, BaseUrl: c.BaseUrl, Client: c.HttpC}
-	return &Core, nil
+	return &Api{Bot: &types.BotApi{Token: token, BaseUrl: c.BaseUrl, Client: c.HttpC}}, nil
 }
 
 // HandleUpdate parses and return update received via webhook
@@ -0,0 +1,83 @@
+package telegram
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/raminsa/telegram-bot-api/config"
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+const (
+	defaultRequestTimeout = 90 * time.Second
+	defaultUploadTimeout  = 2 * time.Minute
+	defaultPollMargin     = 10 * time.Second
+)
+
+// requestTimeout returns the client-side timeout of a request to endpoint, zero means no timeout.
+func (t *Api) requestTimeout(endpoint string, params types.Params, files []types.RequestFile) time.Duration {
+	policy := t.Bot.Timeouts
+	if policy == nil {
+		policy = &types.TimeoutPolicy{}
+	}
+
+	if timeout, ok := policy.Endpoints[endpoint]; ok {
+		if timeout < 0 {
+			return 0
+		}
+		return timeout
+	}
+
+	if endpoint == config.EndpointGetUpdates {
+		if poll, err := strconv.Atoi(params["timeout"]); err == nil && poll > 0 {
+			margin := policy.PollMargin
+			if margin <= 0 {
+				margin = defaultPollMargin
+			}
+			return time.Duration(poll)*time.Second + margin
+		}
+	}
+
+	if len(files) != 0 {
+		return t.uploadTimeout(policy, files)
+	}
+
+	if policy.Default > 0 {
+		return policy.Default
+	}
+	if t.Bot.RequestTimeout > 0 {
+		return t.Bot.RequestTimeout
+	}
+
+	return defaultRequestTimeout
+}
+
+// uploadTimeout returns the timeout of an upload, extended by the time needed to send files at UploadRate.
+func (t *Api) uploadTimeout(policy *types.TimeoutPolicy, files []types.RequestFile) time.Duration {
+	if policy.UnlimitedUploads {
+		return 0
+	}
+
+	timeout := policy.Upload
+	if timeout <= 0 {
+		timeout = t.Bot.RequestTimeout
+	}
+	if timeout <= 0 {
+		timeout = defaultUploadTimeout
+	}
+
+	if policy.UploadRate > 0 {
+		var size int64
+		for _, file := range files {
+			if !file.Data.NeedsUpload() {
+				continue
+			}
+			if n, ok := fileSize(file.Data); ok {
+				size += n
+			}
+		}
+		timeout += time.Duration(size/policy.UploadRate) * time.Second
+	}
+
+	return timeout
+}
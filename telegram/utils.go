@@ -1,7 +1,12 @@
 package telegram
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/raminsa/telegram-bot-api/config"
@@ -85,13 +90,84 @@ func (t *Api) FileAttach(Attach string) types.FileAttach {
 }
 
 // GetFileDirectURL returns direct download URL from file
+//
+// Deprecated: the returned URL contains the bot token, use DownloadFile instead.
 func (t *Api) GetFileDirectURL(fileID string) (string, error) {
-	file, err := t.GetFile(&types.GetFile{FileID: fileID})
+	return t.GetFileDirectURLCtx(context.Background(), fileID)
+}
+
+// GetFileDirectURLCtx is the context-aware variant of GetFileDirectURL.
+func (t *Api) GetFileDirectURLCtx(ctx context.Context, fileID string) (string, error) {
+	file, err := t.GetFileCtx(ctx, &types.GetFile{FileID: fileID})
 	if err != nil {
 		return "", err
 	}
 
-	return file.Link(t.Bot.Token), nil
+	return t.FileLink(file), nil
+}
+
+// FileLink returns the download URL of a file returned by GetFile on the configured Bot API server.
+// A local server returns absolute paths of files stored on its disk, these are returned unchanged.
+// The URL contains the bot token, so it must not be logged or shared, prefer DownloadFile.
+func (t *Api) FileLink(file *types.File) string {
+	if t.Bot.LocalServer && filepath.IsAbs(file.FilePath) {
+		return file.FilePath
+	}
+
+	return t.fileURL(file)
+}
+
+// DownloadFile downloads the file with the given id into w and returns the number of bytes written.
+// The download URL contains the bot token, so it is never returned or included in errors.
+func (t *Api) DownloadFile(fileID string, w io.Writer) (int64, error) {
+	return t.DownloadFileCtx(context.Background(), fileID, w)
+}
+
+// DownloadFileCtx is the context-aware variant of DownloadFile.
+func (t *Api) DownloadFileCtx(ctx context.Context, fileID string, w io.Writer) (int64, error) {
+	file, err := t.GetFileCtx(ctx, &types.GetFile{FileID: fileID})
+	if err != nil {
+		return 0, err
+	}
+
+	if file.FileSize > t.maxDownloadSize() {
+		return 0, fmt.Errorf("file size %d exceeds the download limit of %d bytes", file.FileSize, t.maxDownloadSize())
+	}
+
+	// A local server returns absolute paths of files stored on its disk.
+	if t.Bot.LocalServer && filepath.IsAbs(file.FilePath) {
+		f, err := os.Open(file.FilePath)
+		if err != nil {
+			return 0, err
+		}
+		defer func(f *os.File) {
+			_ = f.Close()
+		}(f)
+
+		return io.Copy(w, f)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.fileURL(file), nil)
+	if err != nil {
+		return 0, t.redact(err)
+	}
+
+	resp, err := t.Bot.Client.Do(req)
+	if err != nil {
+		return 0, t.redact(err)
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, &types.Error{
+			Code:    resp.StatusCode,
+			Message: "file download failed: " + resp.Status,
+		}
+	}
+
+	return io.Copy(w, resp.Body)
 }
 
 // NewLinkPreviewOptions create a new link preview options message.
@@ -0,0 +1,200 @@
+package telegram
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+const (
+	secretTokenHeader      = "X-Telegram-Bot-Api-Secret-Token"
+	defaultWebhookBodySize = 1 << 20
+)
+
+// telegramNetworks are the ranges Telegram sends webhook requests from, see https://core.telegram.org/bots/webhooks.
+var telegramNetworks = []*net.IPNet{
+	mustParseCIDR("149.154.160.0/20"),
+	mustParseCIDR("91.108.4.0/22"),
+}
+
+// WebhookHandler is an http.Handler receiving the updates Telegram sends to a webhook.
+// Requests are checked against the secret token set by SetSecretToken, the update is passed to the handler
+// and 200 is returned once it was handled. A handler error returns 500, so Telegram delivers the update again,
+// except for a *PanicError returned by Recover, the update is logged and answered with 200 then.
+// The handler may answer one API call in the response body with Reply. Updates dropped by Bot.Dedup are answered with 200.
+// Pass UpdateQueue.Push as handler to answer right away and handle the update in the background, a full queue returns 503.
+type WebhookHandler struct {
+	MaxBodySize     int64                                 // Optional. Largest accepted request body in bytes. Defaults to 1MB
+	TelegramIPsOnly bool                                  // Optional. Pass True to reject requests from outside the published Telegram ranges
+	RemoteIP        func(r *http.Request) (net.IP, error) // Optional. Returns the address of the sender, for servers behind a proxy. Defaults to the connection address
+
+	api     *Api
+	handler UpdateHandler
+}
+
+// NewWebhookHandler returns a WebhookHandler passing every verified update to handler.
+func (t *Api) NewWebhookHandler(handler UpdateHandler) *WebhookHandler {
+	return &WebhookHandler{api: t, handler: handler}
+}
+
+// ServeHTTP verifies and decodes the request and handles the update.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	update, status, err := h.receive(w, r)
+	if err != nil {
+		h.api.logger().Warn("rejected webhook request",
+			slog.String("error", err.Error()),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+		webhookError(w, status, err)
+		return
+	}
+
+	if h.api.duplicate(r.Context(), *update) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	h.api.TrackMigration(update)
+
+	reply := &responder{api: h.api}
+	ctx := context.WithValue(r.Context(), responderKey{}, reply)
+	err = h.handler(ctx, *update)
+	body := reply.close()
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		// A panic would repeat on every redelivery, so the update is skipped like Poller does.
+		h.api.logger().Error("skipped update after panic",
+			slog.String("error", err.Error()),
+			slog.Int("update_id", update.UpdateID),
+		)
+		err = nil
+	}
+	if err != nil {
+		if sendErr := reply.send(r.Context()); sendErr != nil {
+			h.api.logger().Error("failed to send held reply",
+				slog.String("error", sendErr.Error()),
+				slog.Int("update_id", update.UpdateID),
+			)
+		}
+		h.api.forget(r.Context(), *update)
+		h.api.logger().Error("failed to handle update",
+			slog.String("error", err.Error()),
+			slog.Int("update_id", update.UpdateID),
+		)
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrQueueFull) || errors.Is(err, ErrQueueClosed) {
+			status = http.StatusServiceUnavailable
+		}
+		webhookError(w, status, errors.New("update not handled"))
+		return
+	}
+
+	if body == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// receive checks a webhook request and decodes its update, returning the status to answer with on failure.
+func (h *WebhookHandler) receive(w http.ResponseWriter, r *http.Request) (*types.Update, int, error) {
+	if r.Method != http.MethodPost {
+		return nil, http.StatusMethodNotAllowed, errors.New("wrong HTTP method required POST")
+	}
+
+	if h.TelegramIPsOnly {
+		ip, err := h.remoteIP(r)
+		if err != nil {
+			return nil, http.StatusForbidden, err
+		}
+		if !isTelegramIP(ip) {
+			return nil, http.StatusForbidden, errors.New("request not sent from a Telegram network")
+		}
+	}
+
+	if secret := h.api.Bot.SecretToken; secret != "" {
+		got := r.Header.Get(secretTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(got), []byte(secret)) != 1 {
+			return nil, http.StatusUnauthorized, errors.New("invalid secret token")
+		}
+	}
+
+	maxBodySize := h.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultWebhookBodySize
+	}
+	body := http.MaxBytesReader(w, r.Body, maxBodySize)
+	defer func() {
+		_ = body.Close()
+	}()
+
+	var update types.Update
+	if err := json.NewDecoder(body).Decode(&update); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, http.StatusRequestEntityTooLarge, err
+		}
+		return nil, http.StatusBadRequest, err
+	}
+	if update.UpdateID == 0 {
+		return nil, http.StatusBadRequest, errors.New("update_id missed")
+	}
+
+	return &update, 0, nil
+}
+
+func (h *WebhookHandler) remoteIP(r *http.Request) (net.IP, error) {
+	if h.RemoteIP != nil {
+		return h.RemoteIP(r)
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, errors.New("invalid remote address")
+	}
+
+	return ip, nil
+}
+
+// isTelegramIP reports whether ip belongs to a Telegram network.
+func isTelegramIP(ip net.IP) bool {
+	for _, network := range telegramNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// webhookError writes err as a JSON error response with status.
+func webhookError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	errMsg, _ := json.Marshal(map[string]string{
+		"error": err.Error(),
+	})
+	_, _ = w.Write(errMsg)
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return network
+}
@@ -0,0 +1,61 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/raminsa/telegram-bot-api/types"
+)
+
+func TestWebhookHandlerStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		method  string
+		body    string
+		handler func(c *UpdateContext) error
+		want    int
+	}{
+		{"handled", "", "", http.MethodPost, `{"update_id":1}`, nil, http.StatusOK},
+		{"wrong method", "", "", http.MethodGet, `{"update_id":1}`, nil, http.StatusMethodNotAllowed},
+		{"wrong secret", "secret", "other", http.MethodPost, `{"update_id":1}`, nil, http.StatusUnauthorized},
+		{"right secret", "secret", "secret", http.MethodPost, `{"update_id":1}`, nil, http.StatusOK},
+		{"bad json", "", "", http.MethodPost, `{"update_id":`, nil, http.StatusBadRequest},
+		{"missing update id", "", "", http.MethodPost, `{}`, nil, http.StatusBadRequest},
+		{"handler error", "", "", http.MethodPost, `{"update_id":1}`, func(c *UpdateContext) error { return errors.New("failed") }, http.StatusInternalServerError},
+		{"queue full", "", "", http.MethodPost, `{"update_id":1}`, func(c *UpdateContext) error { return ErrQueueFull }, http.StatusServiceUnavailable},
+		{"recovered panic", "", "", http.MethodPost, `{"update_id":1}`, func(c *UpdateContext) error { panic("boom") }, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &Api{Bot: &types.BotApi{SecretToken: tt.secret}}
+			dispatcher := api.NewDispatcher()
+			dispatcher.Use(Recover)
+			dispatcher.OnOther(func(c *UpdateContext) error {
+				if tt.handler != nil {
+					return tt.handler(c)
+				}
+				return nil
+			})
+
+			req := httptest.NewRequest(tt.method, "/", strings.NewReader(tt.body))
+			if tt.header != "" {
+				req.Header.Set(secretTokenHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			api.NewWebhookHandler(func(ctx context.Context, update types.Update) error {
+				return dispatcher.Handle(ctx, update)
+			}).ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
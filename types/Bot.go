@@ -1,7 +1,8 @@
 package types
 
 import (
-	"bytes"
+	"context"
+	"log/slog"
 	"net/http"
 	"time"
 )
@@ -10,10 +11,68 @@ import (
 type BotApi struct {
 	Token            string
 	BaseUrl          string
+	LocalServer      bool // the bot talks to a self-hosted telegram-bot-api server started with --local
 	Debug            bool
-	Log              bytes.Buffer
+	Logger           *slog.Logger // library log output, Debug adds request params and raw responses to debug records
+	JSONRequests     bool         // send requests without files as application/json instead of form encoded
 	RequestTimeout   time.Duration
+	Timeouts         *TimeoutPolicy
 	Client           *http.Client
 	SecretToken      string
 	GetUpdateChannel chan any
+	OffsetStore      OffsetStore  // persists the polling offset, updates are committed once they were handled
+	Dedup            DedupStore   // drops updates whose UpdateID was already delivered by polling or the webhook
+	OnDuplicate      func(Update) // called when Dedup dropped an update
+	Retry            *RetryPolicy
+	SpoolUploads     bool // copy uploads from plain readers to a temporary file, so they can be retried
+	Limiter          Limiter
+	FollowMigration  bool                             // re-issue requests against the new supergroup when a group was migrated, uploads only if all files can be replayed
+	OnChatMigrated   func(fromChatID, toChatID int64) // called when a group migration is seen in an error or a service message
+}
+
+// RetryPolicy controls how failed requests are retried.
+// 429 responses wait for the returned retry_after, 5xx responses and network errors use jittered exponential backoff.
+// Non-idempotent endpoints (sending, forwarding, copying, ...) are only retried after a 429 response, unless listed in Endpoints or RetryNonIdempotent is set.
+// Uploads are retried when all their files can be replayed, see ReplayableFileData and BotApi.SpoolUploads.
+type RetryPolicy struct {
+	MaxAttempts        int           // Optional. Total number of attempts including the first one. Defaults to 3
+	MaxWait            time.Duration // Optional. Upper bound for the total time spent waiting between attempts. Zero means no bound
+	BaseDelay          time.Duration // Optional. First backoff delay for 5xx responses and network errors. Defaults to 500ms
+	MaxDelay           time.Duration // Optional. Upper bound for a single backoff delay. Defaults to 30s
+	Endpoints          []string      // Optional. Endpoints that may be retried. If empty, every idempotent endpoint may be retried
+	RetryNonIdempotent bool          // Optional. Pass True to also retry non-idempotent endpoints when Endpoints is empty
+}
+
+// TimeoutPolicy controls the client-side timeout of each request. A deadline on the request context always takes precedence.
+// getUpdates waits for its timeout parameter plus PollMargin, uploads may grow with the payload size.
+type TimeoutPolicy struct {
+	Default          time.Duration            // Optional. Timeout of requests without files. Defaults to RequestTimeout or 90s
+	PollMargin       time.Duration            // Optional. Added to the getUpdates timeout parameter. Defaults to 10s
+	Upload           time.Duration            // Optional. Base timeout of uploads. Defaults to RequestTimeout or 2 minutes
+	UploadRate       int64                    // Optional. Slowest expected upload speed in bytes per second, the time to send the payload at this rate is added to Upload
+	UnlimitedUploads bool                     // Optional. Pass True to never time out uploads
+	Endpoints        map[string]time.Duration // Optional. Timeout per endpoint, taking precedence over the rules above. A negative value disables the timeout
+}
+
+// Limiter paces outgoing requests before they are sent.
+type Limiter interface {
+	// Wait blocks until a request to endpoint with params may be sent.
+	// It returns an error if the request must not be sent, for example when ctx is done first.
+	Wait(ctx context.Context, endpoint string, params Params) error
+}
+
+// OffsetStore persists the update offset of long polling, so updates are neither lost nor handled twice across restarts.
+type OffsetStore interface {
+	// Load returns the stored offset, or 0 if none was stored yet.
+	Load(ctx context.Context) (int, error)
+	// Save stores the offset of the next update to receive.
+	Save(ctx context.Context, offset int) error
+}
+
+// DedupStore remembers the UpdateIDs of recently delivered updates.
+type DedupStore interface {
+	// Seen records updateID and reports whether it was recorded before.
+	Seen(ctx context.Context, updateID int) (bool, error)
+	// Forget removes updateID, so the update is delivered again when Telegram resends it after a failed handler.
+	Forget(ctx context.Context, updateID int) error
 }
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for common Telegram failures. An *Error matches them with errors.Is,
+// for example errors.Is(err, types.ErrBotBlocked).
+var (
+	ErrUnauthorized          = errors.New("unauthorized")
+	ErrTooManyRequests       = errors.New("too many requests")
+	ErrChatMigrated          = errors.New("group chat was upgraded to a supergroup chat")
+	ErrBotBlocked            = errors.New("bot was blocked by the user")
+	ErrBotKicked             = errors.New("bot was kicked from the chat")
+	ErrUserDeactivated       = errors.New("user is deactivated")
+	ErrChatNotFound          = errors.New("chat not found")
+	ErrNotEnoughRights       = errors.New("not enough rights")
+	ErrMessageNotModified    = errors.New("message is not modified")
+	ErrMessageToEditNotFound = errors.New("message to edit not found")
+	ErrMessageToDelNotFound  = errors.New("message to delete not found")
+	ErrCantParseEntities     = errors.New("can't parse entities")
+)
+
+// errorMatchers reports for each sentinel whether an API error belongs to it.
+// It is a list rather than a map, since errors.Is passes targets of any type and unhashable ones would panic as map keys.
+var errorMatchers = []struct {
+	sentinel error
+	match    func(e *Error) bool
+}{
+	{ErrUnauthorized, func(e *Error) bool { return e.Code == 401 }},
+	{ErrTooManyRequests, func(e *Error) bool { return e.Code == 429 }},
+	{ErrChatMigrated, func(e *Error) bool { return e.MigrateToChatID != 0 }},
+	{ErrBotBlocked, descriptionContains("bot was blocked by the user")},
+	{ErrBotKicked, descriptionContains("bot was kicked from")},
+	{ErrUserDeactivated, descriptionContains("user is deactivated")},
+	{ErrChatNotFound, descriptionContains("chat not found")},
+	{ErrNotEnoughRights, descriptionContains("not enough rights")},
+	{ErrMessageNotModified, descriptionContains("message is not modified")},
+	{ErrMessageToEditNotFound, descriptionContains("message to edit not found")},
+	{ErrMessageToDelNotFound, descriptionContains("message to delete not found")},
+	{ErrCantParseEntities, descriptionContains("can't parse entities")},
+}
+
+func descriptionContains(text string) func(e *Error) bool {
+	return func(e *Error) bool {
+		return strings.Contains(strings.ToLower(e.Message), text)
+	}
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package.
+func (e *Error) Is(target error) bool {
+	for _, m := range errorMatchers {
+		if m.sentinel == target {
+			return m.match(e)
+		}
+	}
+
+	return false
+}
+
+// AsError returns the *Error wrapped by err, if any.
+func AsError(err error) (*Error, bool) {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	return nil, false
+}
+
+// RetryAfter returns how long to wait before repeating a request that failed with flood control.
+func RetryAfter(err error) (time.Duration, bool) {
+	apiErr, ok := AsError(err)
+	if !ok || apiErr.RetryAfter == 0 {
+		return 0, false
+	}
+
+	return time.Duration(apiErr.RetryAfter) * time.Second, true
+}
+
+// MigrateToChatID returns the supergroup id of a group that was migrated.
+func MigrateToChatID(err error) (int64, bool) {
+	apiErr, ok := AsError(err)
+	if !ok || apiErr.MigrateToChatID == 0 {
+		return 0, false
+	}
+
+	return apiErr.MigrateToChatID, true
+}
+
+var entityOffsetPattern = regexp.MustCompile(`(?i)(byte|utf-16) offset (\d+)`)
+
+// EntitiesError describes a "can't parse entities" failure.
+type EntitiesError struct {
+	*Error
+	Offset int  // Offset of the invalid entity reported by Telegram
+	UTF16  bool // True, if Offset counts UTF-16 code units instead of bytes
+}
+
+// ParseEntitiesError returns the details of a "can't parse entities" error.
+// It reports false if err is not such an error or the description carries no offset.
+func ParseEntitiesError(err error) (*EntitiesError, bool) {
+	apiErr, ok := AsError(err)
+	if !ok || !errors.Is(apiErr, ErrCantParseEntities) {
+		return nil, false
+	}
+
+	match := entityOffsetPattern.FindStringSubmatch(apiErr.Message)
+	if match == nil {
+		return nil, false
+	}
+
+	offset, err := strconv.Atoi(match[2])
+	if err != nil {
+		return nil, false
+	}
+
+	return &EntitiesError{
+		Error:  apiErr,
+		Offset: offset,
+		UTF16:  strings.EqualFold(match[1], "utf-16"),
+	}, true
+}
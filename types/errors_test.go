@@ -0,0 +1,40 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// multiError is an unhashable error, like the slice based multi-errors of some libraries.
+type multiError []error
+
+func (m multiError) Error() string {
+	return fmt.Sprint([]error(m))
+}
+
+func TestErrorIs(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *Error
+		target error
+		want   bool
+	}{
+		{"unauthorized", &Error{Code: 401, Message: "Unauthorized"}, ErrUnauthorized, true},
+		{"too many requests", &Error{Code: 429, Message: "Too Many Requests: retry after 5"}, ErrTooManyRequests, true},
+		{"migrated", &Error{Code: 400, ResponseParameters: ResponseParameters{MigrateToChatID: -100}}, ErrChatMigrated, true},
+		{"blocked", &Error{Code: 403, Message: "Forbidden: bot was blocked by the user"}, ErrBotBlocked, true},
+		{"description case", &Error{Code: 400, Message: "Bad Request: Chat Not Found"}, ErrChatNotFound, true},
+		{"other sentinel", &Error{Code: 403, Message: "Forbidden: bot was blocked by the user"}, ErrBotKicked, false},
+		{"foreign error", &Error{Code: 401}, errors.New("unauthorized"), false},
+		{"unhashable target", &Error{Code: 401}, multiError{ErrUnauthorized}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
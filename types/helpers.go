@@ -23,6 +23,10 @@ func (fb FileBytes) SendData() string {
 	return "FileBytes must be uploaded"
 }
 
+func (fb FileBytes) CanReplay() bool {
+	return true
+}
+
 func (fr FileReader) NeedsUpload() bool {
 	return true
 }
@@ -35,6 +39,15 @@ func (fr FileReader) SendData() string {
 	return "FileReader must be uploaded"
 }
 
+func (fr FileReader) CanReplay() bool {
+	switch fr.Reader.(type) {
+	case io.Seeker, io.ReaderAt:
+		return true
+	}
+
+	return false
+}
+
 func (fp FilePath) NeedsUpload() bool {
 	return true
 }
@@ -53,6 +66,10 @@ func (fp FilePath) SendData() string {
 	return "FilePath must be uploaded"
 }
 
+func (fp FilePath) CanReplay() bool {
+	return true
+}
+
 func (fu FileURL) NeedsUpload() bool {
 	return false
 }
@@ -65,6 +82,10 @@ func (fu FileURL) SendData() string {
 	return string(fu)
 }
 
+func (fu FileURL) CanReplay() bool {
+	return true
+}
+
 func (fi FileID) NeedsUpload() bool {
 	return false
 }
@@ -77,6 +98,10 @@ func (fi FileID) SendData() string {
 	return string(fi)
 }
 
+func (fi FileID) CanReplay() bool {
+	return true
+}
+
 func (fa FileAttach) NeedsUpload() bool {
 	return false
 }
@@ -89,6 +114,27 @@ func (fa FileAttach) SendData() string {
 	return string(fa)
 }
 
+func (fa FileAttach) CanReplay() bool {
+	return true
+}
+
+func (r RawRequest) Params() (Params, error) {
+	params := make(Params, len(r.Args))
+	for key, value := range r.Args {
+		params[key] = value
+	}
+
+	return params, nil
+}
+
+func (r RawRequest) Files() []RequestFile {
+	return r.Uploads
+}
+
+func (r RawRequest) EndPoint() string {
+	return r.Method
+}
+
 // AddNonEmpty adds a value if it not an empty string.
 func (p Params) AddNonEmpty(key, value string) {
 	if value != "" {
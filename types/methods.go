@@ -1691,8 +1691,11 @@ func (s *GetUserProfilePhotos) EndPoint() string {
 }
 
 // Link returns a full path to the download URL for a File.
+// It always uses api.telegram.org and ignores BotApi.BaseUrl, so the link is wrong for a local Bot API server.
 //
-// It requires the Bot token to create the link.
+// It requires the Bot token to create the link, so the link must not be logged or shared.
+//
+// Deprecated: use Api.DownloadFile, or Api.FileLink if a URL is needed.
 func (f *File) Link(token string) string {
 	return fmt.Sprintf(config.APIFileEndpoint, token, f.FilePath)
 }
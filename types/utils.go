@@ -23,6 +23,13 @@ type Fileable interface {
 	Files() []RequestFile
 }
 
+// RawRequest is a request to any endpoint, for methods that have no config type yet.
+type RawRequest struct {
+	Method  string        // The endpoint name, e.g. sendMessage
+	Args    Params        // Optional. Request parameters
+	Uploads []RequestFile // Optional. Files to send with the request
+}
+
 // RequestFile represents a file associated with a field name.
 type RequestFile struct {
 	// The file field name.
@@ -31,8 +38,13 @@ type RequestFile struct {
 	FileName string
 	// The file data to include.
 	Data RequestFileData
+	// Optional. Called while the file is written to the request body.
+	Progress ProgressFunc
 }
 
+// ProgressFunc reports the number of bytes written so far and the total number of bytes, or -1 if the total is unknown.
+type ProgressFunc func(written, total int64)
+
 // RequestFileData represents the data to be used for a file.
 type RequestFileData interface {
 	// NeedsUpload shows if the file needs to be uploaded.
@@ -46,6 +58,17 @@ type RequestFileData interface {
 	SendData() string
 }
 
+// ReplayableFileData is implemented by file data that can be uploaded more than once,
+// which allows a failed upload to be retried.
+type ReplayableFileData interface {
+	RequestFileData
+
+	// CanReplay reports whether the same content can be read again for another attempt.
+	// A replayed FileReader is read from offset 0 on every attempt, including the first, whether it is
+	// rewound through io.Seeker or io.ReaderAt. Wrap the reader in io.NewSectionReader to upload a part of it.
+	CanReplay() bool
+}
+
 // FileBytes contains information about a set of bytes to upload as a File.
 type FileBytes struct {
 	Name  string
@@ -56,6 +79,7 @@ type FileBytes struct {
 type FileReader struct {
 	Name   string
 	Reader io.Reader
+	Size   int64 // Optional. Number of bytes Reader returns, used to report upload progress
 }
 
 // FilePath is a path to a local file.